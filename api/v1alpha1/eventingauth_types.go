@@ -26,10 +26,145 @@ const (
 	StateNotReady State = "NotReady"
 )
 
+// AnnotationDuplicateApplicationPolicy overrides the policy of the operator to resolve multiple IAS applications with the name of
+// the EventingAuth CR. Value can be one of ("fail", "keep-newest", "keep-matching-status-uuid").
+const AnnotationDuplicateApplicationPolicy = "eventingauth.operator.kyma-project.io/duplicate-application-policy"
+
+// AnnotationForceDelete removes the finalizer of a deleted EventingAuth CR after a best-effort clean-up if set to "true", even if the
+// IAS application or the secret on the managed runtime can't be deleted.
+const AnnotationForceDelete = "eventingauth.operator.kyma-project.io/force-delete"
+
+// AnnotationPaused pauses the reconciliation of an EventingAuth or Kyma CR if set to "true". A paused CR is not changed in IAS and
+// on the managed runtime, and its deletion is deferred until the reconciliation is resumed.
+const AnnotationPaused = "operator.kyma-project.io/eventing-auth-paused"
+
+// AnnotationReconcileRequestedAt requests a reconciliation of the EventingAuth CR when its value changes, e.g. to the current time.
+// The value of the handled request is recorded in the status.
+const AnnotationReconcileRequestedAt = "eventingauth.operator.kyma-project.io/reconcile-requested-at"
+
+// AnnotationRegenerateCredentials requests new credentials for the IAS application and the secret on the managed runtime when its
+// value changes, e.g. to the current time. The value of the handled request is recorded in the status.
+const AnnotationRegenerateCredentials = "eventingauth.operator.kyma-project.io/regenerate-credentials"
+
+// IsPaused returns true if the reconciliation of the object is paused with the AnnotationPaused annotation.
+func IsPaused(obj kmetav1.Object) bool {
+	return obj.GetAnnotations()[AnnotationPaused] == "true"
+}
+
+type CredentialType string
+
+// Valid credential types of the IAS application.
+const (
+	CredentialTypeSecret      CredentialType = "secret"
+	CredentialTypeCertificate CredentialType = "certificate"
+)
+
+type DeletionPolicy string
+
+// Valid deletion policies of the EventingAuth.
+const (
+	// DeletionPolicyDelete deletes the IAS application and the secret on the managed runtime.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// DeletionPolicyOrphan keeps the IAS application and the secret on the managed runtime.
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+	// DeletionPolicyOrphanSecretOnly deletes the IAS application, but keeps the secret on the managed runtime.
+	DeletionPolicyOrphanSecretOnly DeletionPolicy = "OrphanSecretOnly"
+)
+
 // EventingAuthSpec defines the desired state of EventingAuth.
 type EventingAuthSpec struct {
-	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// CredentialType defines the type of credentials issued for the IAS application. Value
+	// can be one of ("secret", "certificate"). With "secret" a client secret is created, with "certificate"
+	// a client certificate is registered and delivered as "tls.crt" and "tls.key" for mTLS-based token retrieval.
+	// +kubebuilder:validation:Enum=secret;certificate
+	// +kubebuilder:default=secret
+	// +optional
+	CredentialType CredentialType `json:"credentialType,omitempty"`
+
+	// ApplicationTemplate overrides settings of the IAS application template configured for the operator.
+	// Only the settings that are set here replace the settings of the operator template.
+	// +optional
+	ApplicationTemplate *IASApplicationTemplate `json:"applicationTemplate,omitempty"`
+
+	// DeletionPolicy defines what happens with the IAS application and the secret on the managed runtime when the
+	// EventingAuth is deleted. Value can be one of ("Delete", "Orphan", "OrphanSecretOnly"). With "Orphan" both are kept,
+	// e.g. to move the EventingAuth to another control plane without interrupting the event delivery. With "OrphanSecretOnly"
+	// only the secret is kept.
+	// +kubebuilder:validation:Enum=Delete;Orphan;OrphanSecretOnly
+	// +kubebuilder:default=Delete
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// GetDeletionPolicy returns the deletion policy of the EventingAuth, which defaults to DeletionPolicyDelete.
+func (s EventingAuthSpec) GetDeletionPolicy() DeletionPolicy {
+	if s.DeletionPolicy == "" {
+		return DeletionPolicyDelete
+	}
+	return s.DeletionPolicy
+}
+
+// IASApplicationTemplate contains the configurable settings of an IAS application. The description and the values of
+// the advanced assertion attributes can use the placeholders {{ .RuntimeID }} and {{ .GlobalAccountID }}.
+type IASApplicationTemplate struct {
+	// Description of the application in IAS
+	// +optional
+	Description *string `json:"description,omitempty"`
+	// JwtValidity is the validity of the issued tokens in seconds
+	// +optional
+	JwtValidity *int `json:"jwtValidity,omitempty"`
+	// RestrictedGrantTypes restricts the OAuth grant types the application can use
+	// +optional
+	RestrictedGrantTypes []string `json:"restrictedGrantTypes,omitempty"`
+	// ProvidedAPIs are the APIs provided by the application
+	// +optional
+	ProvidedAPIs []IASProvidedAPI `json:"providedApis,omitempty"`
+	// ConsumedAPIs are the APIs of other applications consumed by the application
+	// +optional
+	ConsumedAPIs []IASConsumedAPI `json:"consumedApis,omitempty"`
+	// AssertionAttributes map user attributes to attributes of the issued tokens
+	// +optional
+	AssertionAttributes []IASAssertionAttribute `json:"assertionAttributes,omitempty"`
+	// AdvancedAssertionAttributes add attributes with fixed values to the issued tokens
+	// +optional
+	AdvancedAssertionAttributes []IASAdvancedAssertionAttribute `json:"advancedAssertionAttributes,omitempty"`
+	// ParentApplicationID is the ID of the IAS application the application inherits from
+	// +optional
+	ParentApplicationID *string `json:"parentApplicationId,omitempty"`
+}
+
+type IASProvidedAPI struct {
+	// Name of the provided API
+	Name string `json:"name"`
+	// Description of the provided API
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+type IASConsumedAPI struct {
+	// Name of the consumed API in the consuming application
+	Name string `json:"name"`
+	// APIName of the API in the providing application
+	APIName string `json:"apiName"`
+	// AppID of the providing application in IAS
+	AppID string `json:"appId"`
+	// ClientID of the providing application
+	// +optional
+	ClientID string `json:"clientId,omitempty"`
+}
+
+type IASAssertionAttribute struct {
+	// AssertionAttributeName is the name of the attribute in the issued token
+	AssertionAttributeName string `json:"assertionAttributeName"`
+	// UserAttributeName is the name of the user attribute that is mapped
+	UserAttributeName string `json:"userAttributeName"`
+}
+
+type IASAdvancedAssertionAttribute struct {
+	// AttributeName is the name of the attribute in the issued token
+	AttributeName string `json:"attributeName"`
+	// AttributeValue is the value of the attribute in the issued token
+	AttributeValue string `json:"attributeValue"`
 }
 
 // EventingAuthStatus defines the observed state of EventingAuth.
@@ -44,6 +179,14 @@ type EventingAuthStatus struct {
 	Application *IASApplication `json:"iasApplication,omitempty"`
 	// AuthSecret contains information about created K8s secret
 	AuthSecret *AuthSecret `json:"secret,omitempty"`
+	// LastHandledRequests contains the values of the request annotations that were handled last
+	LastHandledRequests *HandledRequests `json:"lastHandledRequests,omitempty"`
+	// ObservedGeneration is the generation of the EventingAuth CR that was reconciled last
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// LastSuccessfulSyncTime is the time the IAS application and the secret were last verified or created successfully
+	// +optional
+	LastSuccessfulSyncTime *kmetav1.Time `json:"lastSuccessfulSyncTime,omitempty"`
 
 	//  Conditions associated with EventingAuthStatus.
 	Conditions []kmetav1.Condition `json:"conditions,omitempty"`
@@ -56,6 +199,15 @@ type IASApplication struct {
 	UUID string `json:"uuid"`
 }
 
+type HandledRequests struct {
+	// ReconcileRequestedAt is the value of the reconcile-requested-at annotation that was handled last
+	// +optional
+	ReconcileRequestedAt string `json:"reconcileRequestedAt,omitempty"`
+	// RegenerateCredentials is the value of the regenerate-credentials annotation that was handled last
+	// +optional
+	RegenerateCredentials string `json:"regenerateCredentials,omitempty"`
+}
+
 type AuthSecret struct {
 	// NamespacedName of the secret on the managed runtime cluster
 	NamespacedName string `json:"namespacedName"`
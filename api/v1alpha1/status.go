@@ -1,7 +1,9 @@
 package v1alpha1
 
 import (
+	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/pkg/errors"
 	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -12,6 +14,14 @@ type ConditionType string
 const (
 	ConditionApplicationReady ConditionType = "IASApplicationReady"
 	ConditionSecretReady      ConditionType = "SecretReady"
+	// ConditionDuplicateApplications is true if multiple IAS applications with the name of the EventingAuth CR exist.
+	ConditionDuplicateApplications ConditionType = "DuplicateApplications"
+	// ConditionPaused is true if the reconciliation of the EventingAuth CR is paused with the AnnotationPaused annotation.
+	ConditionPaused ConditionType = "Paused"
+	// ConditionReady summarizes the conditions that must be true for the EventingAuth CR to be ready.
+	ConditionReady ConditionType = "Ready"
+	// ConditionDeletionBlocked is true if the finalizer of the deleted EventingAuth CR can't be removed yet.
+	ConditionDeletionBlocked ConditionType = "DeletionBlocked"
 )
 
 type ConditionReason string
@@ -21,93 +31,242 @@ const (
 	ConditionReasonSecretCreated             string = "SecretCreated"
 	ConditionReasonApplicationCreationFailed string = "IASApplicationCreationFailed"
 	ConditionReasonSecretCreationFailed      string = "SecretCreationFailed"
+	ConditionReasonDuplicatesFound           string = "DuplicateApplicationsFound"
+	ConditionReasonDuplicatesResolved        string = "DuplicateApplicationsResolved"
+	ConditionReasonPaused                    string = "ReconciliationPaused"
+	ConditionReasonResumed                   string = "ReconciliationResumed"
+	ConditionReasonReady                     string = "Ready"
+	ConditionReasonNotReady                  string = "NotReady"
+	ConditionReasonCleanUpFailed             string = "CleanUpFailed"
+	ConditionReasonSucceeded                 string = "Succeeded"
+	ConditionReasonFailed                    string = "Failed"
 )
 
+// Reasons of failed conditions that classify the cause of the failure, so that alerts can be routed by cause.
+const (
+	ConditionReasonIASCredentialsMissing string = "IASCredentialsMissing"
+	ConditionReasonIASCredentialsInvalid string = "IASCredentialsInvalid"
+	ConditionReasonIASUnauthorized       string = "IASUnauthorized"
+	ConditionReasonIASRateLimited        string = "IASRateLimited"
+	ConditionReasonOIDCDiscoveryFailed   string = "OIDCDiscoveryFailed"
+	ConditionReasonSKRUnreachable        string = "SKRUnreachable"
+	ConditionReasonKubeconfigMissing     string = "KubeconfigMissing"
+	ConditionReasonSecretConflict        string = "SecretConflict"
+)
+
+// ConditionReasonError sets the reason of the condition that fails with the wrapped error instead of the default failure reason of
+// the condition type.
+type ConditionReasonError struct {
+	Reason string
+	Err    error
+}
+
+// NewConditionReasonError returns an error that fails the condition with the given reason. If the reason is empty, the error is
+// returned as is.
+func NewConditionReasonError(reason string, err error) error {
+	if reason == "" || err == nil {
+		return err
+	}
+	return &ConditionReasonError{Reason: reason, Err: err}
+}
+
+func (e *ConditionReasonError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ConditionReasonError) Unwrap() error {
+	return e.Err
+}
+
 const (
 	ConditionMessageApplicationCreated string = "IAS application is successfully created."
 	ConditionMessageSecretCreated      string = "Eventing webhook authentication secret is successfully created."
+	ConditionMessagePaused             string = "Reconciliation is paused with the annotation " + AnnotationPaused + "."
+	ConditionMessageResumed            string = "Reconciliation is resumed."
+	ConditionMessageReady              string = "IAS application and eventing webhook authentication secret are ready."
+	ConditionMessageDeletionPaused     string = "Deletion is deferred until the reconciliation is resumed."
 )
 
+// conditionResult contains the reasons and the message on success of a condition that reflects the result of a reconciliation step.
+type conditionResult struct {
+	reasonSucceeded  string
+	messageSucceeded string
+	reasonFailed     string
+}
+
+// conditionResults contains the results of the known conditions. Other conditions use ConditionReasonSucceeded and ConditionReasonFailed.
+var conditionResults = map[ConditionType]conditionResult{ //nolint:gochecknoglobals // Lookup table of the condition results.
+	ConditionApplicationReady: {
+		reasonSucceeded:  ConditionReasonApplicationCreated,
+		messageSucceeded: ConditionMessageApplicationCreated,
+		reasonFailed:     ConditionReasonApplicationCreationFailed,
+	},
+	ConditionSecretReady: {
+		reasonSucceeded:  ConditionReasonSecretCreated,
+		messageSucceeded: ConditionMessageSecretCreated,
+		reasonFailed:     ConditionReasonSecretCreationFailed,
+	},
+}
+
+// readyConditionTypes are the conditions that must be true for the EventingAuth CR to be ready.
+var readyConditionTypes = []ConditionType{ConditionApplicationReady, ConditionSecretReady} //nolint:gochecknoglobals // Read-only list.
+
+// UpdateConditionAndState updates the condition of the given type based on the given error value, and derives the state, the Ready
+// condition and the observed generation from it. The Ready condition can't be updated directly.
 func UpdateConditionAndState(eventingAuth *EventingAuth, conditionType ConditionType, err error) (EventingAuthStatus, error) {
-	switch conditionType {
-	case ConditionApplicationReady:
-		{
-			eventingAuth.Status.Conditions = MakeApplicationReadyCondition(eventingAuth, err)
-		}
-	case ConditionSecretReady:
-		{
-			eventingAuth.Status.Conditions = MakeSecretReadyCondition(eventingAuth, err)
-		}
-	default:
-		return eventingAuth.Status, errors.Errorf("unsupported condition type: %s", conditionType)
+	if conditionType == ConditionReady {
+		return eventingAuth.Status, errors.Errorf("unsupported condition type: %s is derived from the other conditions", conditionType)
 	}
+	eventingAuth.Status.Conditions = MakeCondition(eventingAuth, conditionType, err)
 
 	if err != nil {
 		eventingAuth.Status.State = StateNotReady
 	} else {
 		eventingAuth.Status.State = determineEventingAuthState(eventingAuth.Status)
 	}
+	eventingAuth.Status.Conditions = MakeReadyCondition(eventingAuth)
+	eventingAuth.Status.ObservedGeneration = eventingAuth.Generation
 	return eventingAuth.Status, nil
 }
 
-// MakeApplicationReadyCondition updates the ConditionApplicationActive condition based on the given error value.
-func MakeApplicationReadyCondition(eventingAuth *EventingAuth, err error) []kmetav1.Condition {
-	applicationReadyCondition := kmetav1.Condition{
-		Type:               string(ConditionApplicationReady),
-		LastTransitionTime: kmetav1.Now(),
+// MakeCondition updates the condition of the given type based on the given error value. The reason of a failed condition is taken
+// from a ConditionReasonError if the error wraps one.
+func MakeCondition(eventingAuth *EventingAuth, conditionType ConditionType, err error) []kmetav1.Condition {
+	result, known := conditionResults[conditionType]
+	if !known {
+		result = conditionResult{reasonSucceeded: ConditionReasonSucceeded, reasonFailed: ConditionReasonFailed}
 	}
+
+	condition := kmetav1.Condition{Type: string(conditionType)}
 	if err == nil {
-		applicationReadyCondition.Status = kmetav1.ConditionTrue
-		applicationReadyCondition.Reason = ConditionReasonApplicationCreated
-		applicationReadyCondition.Message = ConditionMessageApplicationCreated
+		condition.Status = kmetav1.ConditionTrue
+		condition.Reason = result.reasonSucceeded
+		condition.Message = result.messageSucceeded
 	} else {
-		applicationReadyCondition.Message = err.Error()
-		applicationReadyCondition.Reason = ConditionReasonApplicationCreationFailed
-		applicationReadyCondition.Status = kmetav1.ConditionFalse
+		condition.Status = kmetav1.ConditionFalse
+		condition.Reason = result.reasonFailed
+		condition.Message = err.Error()
+		var reasonErr *ConditionReasonError
+		if errors.As(err, &reasonErr) {
+			condition.Reason = reasonErr.Reason
+		}
 	}
+	return SetCondition(eventingAuth, condition)
+}
+
+// SetCondition adds or replaces the condition of the same type and records the generation of the EventingAuth in the condition. An
+// equal condition is kept as is, and the last transition time is only updated if the status of the condition changes.
+func SetCondition(eventingAuth *EventingAuth, condition kmetav1.Condition) []kmetav1.Condition {
+	condition.ObservedGeneration = eventingAuth.Generation
+	condition.LastTransitionTime = kmetav1.Now()
 	for ix, activeCond := range eventingAuth.Status.Conditions {
-		if activeCond.Type == string(ConditionApplicationReady) {
-			if applicationReadyCondition.Status == activeCond.Status &&
-				applicationReadyCondition.Reason == activeCond.Reason &&
-				applicationReadyCondition.Message == activeCond.Message {
-				return eventingAuth.Status.Conditions
-			} else {
-				eventingAuth.Status.Conditions[ix] = applicationReadyCondition
+		if activeCond.Type == condition.Type {
+			if ConditionEquals(activeCond, condition) {
 				return eventingAuth.Status.Conditions
 			}
+			if activeCond.Status == condition.Status {
+				condition.LastTransitionTime = activeCond.LastTransitionTime
+			}
+			eventingAuth.Status.Conditions[ix] = condition
+			return eventingAuth.Status.Conditions
 		}
 	}
-	return append(eventingAuth.Status.Conditions, applicationReadyCondition)
+	return append(eventingAuth.Status.Conditions, condition)
+}
+
+// MakeApplicationReadyCondition updates the ConditionApplicationActive condition based on the given error value.
+func MakeApplicationReadyCondition(eventingAuth *EventingAuth, err error) []kmetav1.Condition {
+	return MakeCondition(eventingAuth, ConditionApplicationReady, err)
 }
 
 // MakeSecretReadyCondition updates the ConditionSecretReady condition based on the given error value.
 func MakeSecretReadyCondition(eventingAuth *EventingAuth, err error) []kmetav1.Condition {
-	secretReadyCondition := kmetav1.Condition{
-		Type:               string(ConditionSecretReady),
-		LastTransitionTime: kmetav1.Now(),
+	return MakeCondition(eventingAuth, ConditionSecretReady, err)
+}
+
+// MakeReadyCondition updates the ConditionReady condition based on the state. If the EventingAuth CR is not ready, the reason and the
+// message are taken from the first condition that prevents the EventingAuth CR from being ready.
+func MakeReadyCondition(eventingAuth *EventingAuth) []kmetav1.Condition {
+	readyCondition := kmetav1.Condition{
+		Type:    string(ConditionReady),
+		Status:  kmetav1.ConditionTrue,
+		Reason:  ConditionReasonReady,
+		Message: ConditionMessageReady,
 	}
-	if err == nil {
-		secretReadyCondition.Status = kmetav1.ConditionTrue
-		secretReadyCondition.Reason = ConditionReasonSecretCreated
-		secretReadyCondition.Message = ConditionMessageSecretCreated
+	if eventingAuth.Status.State != StateReady {
+		readyCondition.Status = kmetav1.ConditionFalse
+		readyCondition.Reason = ConditionReasonNotReady
+		readyCondition.Message = "EventingAuth is not ready."
+		for _, conditionType := range readyConditionTypes {
+			condition := findCondition(eventingAuth.Status.Conditions, conditionType)
+			if condition == nil {
+				readyCondition.Message = fmt.Sprintf("Waiting for condition %s.", conditionType)
+				break
+			}
+			if condition.Status != kmetav1.ConditionTrue {
+				readyCondition.Reason = condition.Reason
+				readyCondition.Message = condition.Message
+				break
+			}
+		}
+	}
+	return SetCondition(eventingAuth, readyCondition)
+}
+
+// MakeDeletionBlockedCondition sets the ConditionDeletionBlocked condition with the reason and the message why the finalizer of the
+// deleted EventingAuth CR can't be removed.
+func MakeDeletionBlockedCondition(eventingAuth *EventingAuth, reason, message string) []kmetav1.Condition {
+	return SetCondition(eventingAuth, kmetav1.Condition{
+		Type:    string(ConditionDeletionBlocked),
+		Status:  kmetav1.ConditionTrue,
+		Reason:  reason,
+		Message: message,
+	})
+}
+
+// MakeDuplicateApplicationsCondition updates the ConditionDuplicateApplications condition with the IDs of the IAS applications found
+// with the same name. If keptID is empty, the duplicates are unresolved, otherwise all applications except the kept one were deleted.
+func MakeDuplicateApplicationsCondition(eventingAuth *EventingAuth, ids []string, keptID string) []kmetav1.Condition {
+	duplicatesCondition := kmetav1.Condition{
+		Type: string(ConditionDuplicateApplications),
+	}
+	if keptID == "" {
+		duplicatesCondition.Status = kmetav1.ConditionTrue
+		duplicatesCondition.Reason = ConditionReasonDuplicatesFound
+		duplicatesCondition.Message = fmt.Sprintf("Found multiple IAS applications with the same name: %s.", strings.Join(ids, ", "))
 	} else {
-		secretReadyCondition.Message = err.Error()
-		secretReadyCondition.Reason = ConditionReasonSecretCreationFailed
-		secretReadyCondition.Status = kmetav1.ConditionFalse
+		duplicatesCondition.Status = kmetav1.ConditionFalse
+		duplicatesCondition.Reason = ConditionReasonDuplicatesResolved
+		duplicatesCondition.Message = fmt.Sprintf("Resolved multiple IAS applications with the same name: %s. Kept IAS application %s.",
+			strings.Join(ids, ", "), keptID)
 	}
-	for ix, activeCond := range eventingAuth.Status.Conditions {
-		if activeCond.Type == string(ConditionSecretReady) {
-			if secretReadyCondition.Status == activeCond.Status &&
-				secretReadyCondition.Reason == activeCond.Reason &&
-				secretReadyCondition.Message == activeCond.Message {
-				return eventingAuth.Status.Conditions
-			} else {
-				eventingAuth.Status.Conditions[ix] = secretReadyCondition
-				return eventingAuth.Status.Conditions
-			}
+	return SetCondition(eventingAuth, duplicatesCondition)
+}
+
+// MakePausedCondition updates the ConditionPaused condition based on whether the reconciliation is paused.
+func MakePausedCondition(eventingAuth *EventingAuth, paused bool) []kmetav1.Condition {
+	pausedCondition := kmetav1.Condition{
+		Type: string(ConditionPaused),
+	}
+	if paused {
+		pausedCondition.Status = kmetav1.ConditionTrue
+		pausedCondition.Reason = ConditionReasonPaused
+		pausedCondition.Message = ConditionMessagePaused
+	} else {
+		pausedCondition.Status = kmetav1.ConditionFalse
+		pausedCondition.Reason = ConditionReasonResumed
+		pausedCondition.Message = ConditionMessageResumed
+	}
+	return SetCondition(eventingAuth, pausedCondition)
+}
+
+func findCondition(conditions []kmetav1.Condition, conditionType ConditionType) *kmetav1.Condition {
+	for i := range conditions {
+		if conditions[i].Type == string(conditionType) {
+			return &conditions[i]
 		}
 	}
-	return append(eventingAuth.Status.Conditions, secretReadyCondition)
+	return nil
 }
 
 // ConditionsEqual checks if two list of conditions are equal.
@@ -138,8 +297,9 @@ func ConditionEquals(existing, expected kmetav1.Condition) bool {
 	isStatusEqual := existing.Status == expected.Status
 	isReasonEqual := existing.Reason == expected.Reason
 	isMessageEqual := existing.Message == expected.Message
+	isObservedGenerationEqual := existing.ObservedGeneration == expected.ObservedGeneration
 
-	return isStatusEqual && isReasonEqual && isMessageEqual && isTypeEqual
+	return isStatusEqual && isReasonEqual && isMessageEqual && isTypeEqual && isObservedGenerationEqual
 }
 
 func IsEventingAuthStatusEqual(oldStatus, newStatus EventingAuthStatus) bool {
@@ -156,17 +316,11 @@ func IsEventingAuthStatusEqual(oldStatus, newStatus EventingAuthStatus) bool {
 
 // determineEventingAuthState returns 'Ready' if both IAS app and secret are created, otherwise 'NoReady'.
 func determineEventingAuthState(status EventingAuthStatus) State {
-	var applicationReady, secretReady bool
-	for _, cond := range status.Conditions {
-		if cond.Type == string(ConditionApplicationReady) {
-			applicationReady = cond.Status == kmetav1.ConditionTrue
-		}
-		if cond.Type == string(ConditionSecretReady) {
-			secretReady = cond.Status == kmetav1.ConditionTrue
+	for _, conditionType := range readyConditionTypes {
+		condition := findCondition(status.Conditions, conditionType)
+		if condition == nil || condition.Status != kmetav1.ConditionTrue {
+			return StateNotReady
 		}
 	}
-	if applicationReady && secretReady {
-		return StateReady
-	}
-	return StateNotReady
+	return StateReady
 }
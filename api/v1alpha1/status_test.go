@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -172,6 +173,19 @@ func Test_MakeApplicationReadyCondition(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:              "Should use reason of the error if error classifies the failure",
+			givenEventingAuth: createEventingAuthWith(EventingAuthStatus{Conditions: []kmetav1.Condition{}}),
+			givenErr:          NewConditionReasonError(ConditionReasonIASUnauthorized, errors.Errorf(mockErrorMessage)),
+			wantConditions: []kmetav1.Condition{
+				{
+					Type:    string(ConditionApplicationReady),
+					Status:  kmetav1.ConditionFalse,
+					Reason:  ConditionReasonIASUnauthorized,
+					Message: mockErrorMessage,
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -183,6 +197,126 @@ func Test_MakeApplicationReadyCondition(t *testing.T) {
 	}
 }
 
+func Test_MakeDeletionBlockedCondition(t *testing.T) {
+	// given
+	eventingAuth := createEventingAuthWith(EventingAuthStatus{Conditions: []kmetav1.Condition{}})
+
+	// when
+	actualConditions := MakeDeletionBlockedCondition(eventingAuth, ConditionReasonCleanUpFailed, mockErrorMessage)
+
+	// then
+	require.True(t, ConditionsEqual([]kmetav1.Condition{
+		{
+			Type:    string(ConditionDeletionBlocked),
+			Status:  kmetav1.ConditionTrue,
+			Reason:  ConditionReasonCleanUpFailed,
+			Message: mockErrorMessage,
+		},
+	}, actualConditions))
+}
+
+func Test_MakeDuplicateApplicationsCondition(t *testing.T) {
+	tests := []struct {
+		name              string
+		givenEventingAuth *EventingAuth
+		givenKeptID       string
+		wantConditions    []kmetav1.Condition
+	}{
+		{
+			name:              "Should add condition with found duplicates if unresolved",
+			givenEventingAuth: createEventingAuthWith(EventingAuthStatus{Conditions: []kmetav1.Condition{}}),
+			wantConditions: []kmetav1.Condition{
+				{
+					Type:    string(ConditionDuplicateApplications),
+					Status:  kmetav1.ConditionTrue,
+					Reason:  ConditionReasonDuplicatesFound,
+					Message: "Found multiple IAS applications with the same name: id-1, id-2.",
+				},
+			},
+		},
+		{
+			name: "Should update condition to false if resolved",
+			givenEventingAuth: createEventingAuthWith(EventingAuthStatus{Conditions: []kmetav1.Condition{
+				{
+					Type:    string(ConditionDuplicateApplications),
+					Status:  kmetav1.ConditionTrue,
+					Reason:  ConditionReasonDuplicatesFound,
+					Message: "Found multiple IAS applications with the same name: id-1, id-2.",
+				},
+			}}),
+			givenKeptID: "id-2",
+			wantConditions: []kmetav1.Condition{
+				{
+					Type:    string(ConditionDuplicateApplications),
+					Status:  kmetav1.ConditionFalse,
+					Reason:  ConditionReasonDuplicatesResolved,
+					Message: "Resolved multiple IAS applications with the same name: id-1, id-2. Kept IAS application id-2.",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actualConditions := MakeDuplicateApplicationsCondition(tt.givenEventingAuth, []string{"id-1", "id-2"}, tt.givenKeptID)
+			// then
+			require.True(t, ConditionsEqual(tt.wantConditions, actualConditions))
+		})
+	}
+}
+
+func Test_MakePausedCondition(t *testing.T) {
+	tests := []struct {
+		name              string
+		givenEventingAuth *EventingAuth
+		givenPaused       bool
+		wantConditions    []kmetav1.Condition
+	}{
+		{
+			name:              "Should add condition if paused",
+			givenEventingAuth: createEventingAuthWith(EventingAuthStatus{Conditions: []kmetav1.Condition{}}),
+			givenPaused:       true,
+			wantConditions: []kmetav1.Condition{
+				{
+					Type:    string(ConditionPaused),
+					Status:  kmetav1.ConditionTrue,
+					Reason:  ConditionReasonPaused,
+					Message: ConditionMessagePaused,
+				},
+			},
+		},
+		{
+			name: "Should update condition to false if resumed",
+			givenEventingAuth: createEventingAuthWith(EventingAuthStatus{Conditions: []kmetav1.Condition{
+				{
+					Type:    string(ConditionPaused),
+					Status:  kmetav1.ConditionTrue,
+					Reason:  ConditionReasonPaused,
+					Message: ConditionMessagePaused,
+				},
+			}}),
+			givenPaused: false,
+			wantConditions: []kmetav1.Condition{
+				{
+					Type:    string(ConditionPaused),
+					Status:  kmetav1.ConditionFalse,
+					Reason:  ConditionReasonResumed,
+					Message: ConditionMessageResumed,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			actualConditions := MakePausedCondition(tt.givenEventingAuth, tt.givenPaused)
+			// then
+			require.True(t, ConditionsEqual(tt.wantConditions, actualConditions))
+		})
+	}
+}
+
 func Test_MakeSecretReadyCondition(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -289,8 +423,72 @@ func Test_MakeSecretReadyCondition(t *testing.T) {
 	}
 }
 
+func Test_SetCondition(t *testing.T) {
+	transitionTime := kmetav1.NewTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name                   string
+		givenConditions        []kmetav1.Condition
+		givenCondition         kmetav1.Condition
+		wantObservedGeneration int64
+		wantTransitionTimeKept bool
+	}{
+		{
+			name:                   "Should add condition with observed generation",
+			givenConditions:        []kmetav1.Condition{},
+			givenCondition:         kmetav1.Condition{Type: string(ConditionPaused), Status: kmetav1.ConditionTrue, Reason: ConditionReasonPaused},
+			wantObservedGeneration: 2,
+		},
+		{
+			name: "Should keep transition time but update observed generation if only the generation changed",
+			givenConditions: []kmetav1.Condition{
+				{
+					Type:               string(ConditionPaused),
+					Status:             kmetav1.ConditionTrue,
+					Reason:             ConditionReasonPaused,
+					ObservedGeneration: 1,
+					LastTransitionTime: transitionTime,
+				},
+			},
+			givenCondition:         kmetav1.Condition{Type: string(ConditionPaused), Status: kmetav1.ConditionTrue, Reason: ConditionReasonPaused},
+			wantObservedGeneration: 2,
+			wantTransitionTimeKept: true,
+		},
+		{
+			name: "Should update transition time if status changed",
+			givenConditions: []kmetav1.Condition{
+				{
+					Type:               string(ConditionPaused),
+					Status:             kmetav1.ConditionTrue,
+					Reason:             ConditionReasonPaused,
+					ObservedGeneration: 2,
+					LastTransitionTime: transitionTime,
+				},
+			},
+			givenCondition:         kmetav1.Condition{Type: string(ConditionPaused), Status: kmetav1.ConditionFalse, Reason: ConditionReasonResumed},
+			wantObservedGeneration: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			eventingAuth := createEventingAuthWith(EventingAuthStatus{Conditions: tt.givenConditions})
+			eventingAuth.Generation = 2
+
+			// when
+			actualConditions := SetCondition(eventingAuth, tt.givenCondition)
+
+			// then
+			require.Len(t, actualConditions, 1)
+			require.Equal(t, tt.givenCondition.Status, actualConditions[0].Status)
+			require.Equal(t, tt.wantObservedGeneration, actualConditions[0].ObservedGeneration)
+			require.Equal(t, tt.wantTransitionTimeKept, actualConditions[0].LastTransitionTime.Equal(&transitionTime))
+		})
+	}
+}
+
 func Test_UpdateConditionAndState(t *testing.T) {
-	const invalidConditionType = "InvalidConditionType"
+	const genericConditionType = "GenericCondition"
 	tests := []struct {
 		name              string
 		givenEventingAuth *EventingAuth
@@ -334,6 +532,12 @@ func Test_UpdateConditionAndState(t *testing.T) {
 						Reason:  ConditionReasonSecretCreationFailed,
 						Message: mockErrorMessage,
 					},
+					{
+						Type:    string(ConditionReady),
+						Status:  kmetav1.ConditionFalse,
+						Reason:  ConditionReasonSecretCreationFailed,
+						Message: mockErrorMessage,
+					},
 				},
 				State: StateNotReady,
 			},
@@ -372,6 +576,12 @@ func Test_UpdateConditionAndState(t *testing.T) {
 						Reason:  ConditionReasonSecretCreated,
 						Message: ConditionMessageSecretCreated,
 					},
+					{
+						Type:    string(ConditionReady),
+						Status:  kmetav1.ConditionTrue,
+						Reason:  ConditionReasonReady,
+						Message: ConditionMessageReady,
+					},
 				},
 				State: StateReady,
 			},
@@ -398,12 +608,57 @@ func Test_UpdateConditionAndState(t *testing.T) {
 						Reason:  ConditionReasonApplicationCreated,
 						Message: ConditionMessageApplicationCreated,
 					},
+					{
+						Type:    string(ConditionReady),
+						Status:  kmetav1.ConditionFalse,
+						Reason:  ConditionReasonNotReady,
+						Message: "Waiting for condition SecretReady.",
+					},
+				},
+				State: StateNotReady,
+			},
+		},
+		{
+			name: "Should update a condition without specific reasons with the generic reasons",
+			givenEventingAuth: createEventingAuthWith(EventingAuthStatus{
+				Conditions: []kmetav1.Condition{
+					{
+						Type:    string(ConditionApplicationReady),
+						Status:  kmetav1.ConditionTrue,
+						Reason:  ConditionReasonApplicationCreated,
+						Message: ConditionMessageApplicationCreated,
+					},
+				},
+				State: StateNotReady,
+			}),
+			conditionType: genericConditionType,
+			givenErr:      errors.Errorf(mockErrorMessage),
+			wantStatus: EventingAuthStatus{
+				Conditions: []kmetav1.Condition{
+					{
+						Type:    string(ConditionApplicationReady),
+						Status:  kmetav1.ConditionTrue,
+						Reason:  ConditionReasonApplicationCreated,
+						Message: ConditionMessageApplicationCreated,
+					},
+					{
+						Type:    genericConditionType,
+						Status:  kmetav1.ConditionFalse,
+						Reason:  ConditionReasonFailed,
+						Message: mockErrorMessage,
+					},
+					{
+						Type:    string(ConditionReady),
+						Status:  kmetav1.ConditionFalse,
+						Reason:  ConditionReasonNotReady,
+						Message: "Waiting for condition SecretReady.",
+					},
 				},
 				State: StateNotReady,
 			},
 		},
 		{
-			name: "Should fail if invalid condition type is provided",
+			name: "Should fail if the derived Ready condition type is provided",
 			givenEventingAuth: createEventingAuthWith(EventingAuthStatus{
 				Conditions: []kmetav1.Condition{
 					{
@@ -415,8 +670,8 @@ func Test_UpdateConditionAndState(t *testing.T) {
 				},
 				State: StateNotReady,
 			}),
-			conditionType: invalidConditionType,
-			wantError:     errors.Errorf("unsupported condition type: %s", invalidConditionType),
+			conditionType: ConditionReady,
+			wantError:     errors.Errorf("unsupported condition type: %s is derived from the other conditions", ConditionReady),
 		},
 	}
 
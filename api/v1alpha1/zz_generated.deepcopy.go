@@ -45,7 +45,7 @@ func (in *EventingAuth) DeepCopyInto(out *EventingAuth) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -102,6 +102,11 @@ func (in *EventingAuthList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventingAuthSpec) DeepCopyInto(out *EventingAuthSpec) {
 	*out = *in
+	if in.ApplicationTemplate != nil {
+		in, out := &in.ApplicationTemplate, &out.ApplicationTemplate
+		*out = new(IASApplicationTemplate)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventingAuthSpec.
@@ -127,6 +132,15 @@ func (in *EventingAuthStatus) DeepCopyInto(out *EventingAuthStatus) {
 		*out = new(AuthSecret)
 		**out = **in
 	}
+	if in.LastHandledRequests != nil {
+		in, out := &in.LastHandledRequests, &out.LastHandledRequests
+		*out = new(HandledRequests)
+		**out = **in
+	}
+	if in.LastSuccessfulSyncTime != nil {
+		in, out := &in.LastSuccessfulSyncTime, &out.LastSuccessfulSyncTime
+		*out = (*in).DeepCopy()
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -146,6 +160,36 @@ func (in *EventingAuthStatus) DeepCopy() *EventingAuthStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HandledRequests) DeepCopyInto(out *HandledRequests) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HandledRequests.
+func (in *HandledRequests) DeepCopy() *HandledRequests {
+	if in == nil {
+		return nil
+	}
+	out := new(HandledRequests)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IASAdvancedAssertionAttribute) DeepCopyInto(out *IASAdvancedAssertionAttribute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IASAdvancedAssertionAttribute.
+func (in *IASAdvancedAssertionAttribute) DeepCopy() *IASAdvancedAssertionAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(IASAdvancedAssertionAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IASApplication) DeepCopyInto(out *IASApplication) {
 	*out = *in
@@ -160,3 +204,103 @@ func (in *IASApplication) DeepCopy() *IASApplication {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IASApplicationTemplate) DeepCopyInto(out *IASApplicationTemplate) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.JwtValidity != nil {
+		in, out := &in.JwtValidity, &out.JwtValidity
+		*out = new(int)
+		**out = **in
+	}
+	if in.RestrictedGrantTypes != nil {
+		in, out := &in.RestrictedGrantTypes, &out.RestrictedGrantTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProvidedAPIs != nil {
+		in, out := &in.ProvidedAPIs, &out.ProvidedAPIs
+		*out = make([]IASProvidedAPI, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConsumedAPIs != nil {
+		in, out := &in.ConsumedAPIs, &out.ConsumedAPIs
+		*out = make([]IASConsumedAPI, len(*in))
+		copy(*out, *in)
+	}
+	if in.AssertionAttributes != nil {
+		in, out := &in.AssertionAttributes, &out.AssertionAttributes
+		*out = make([]IASAssertionAttribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdvancedAssertionAttributes != nil {
+		in, out := &in.AdvancedAssertionAttributes, &out.AdvancedAssertionAttributes
+		*out = make([]IASAdvancedAssertionAttribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.ParentApplicationID != nil {
+		in, out := &in.ParentApplicationID, &out.ParentApplicationID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IASApplicationTemplate.
+func (in *IASApplicationTemplate) DeepCopy() *IASApplicationTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(IASApplicationTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IASAssertionAttribute) DeepCopyInto(out *IASAssertionAttribute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IASAssertionAttribute.
+func (in *IASAssertionAttribute) DeepCopy() *IASAssertionAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(IASAssertionAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IASConsumedAPI) DeepCopyInto(out *IASConsumedAPI) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IASConsumedAPI.
+func (in *IASConsumedAPI) DeepCopy() *IASConsumedAPI {
+	if in == nil {
+		return nil
+	}
+	out := new(IASConsumedAPI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IASProvidedAPI) DeepCopyInto(out *IASProvidedAPI) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IASProvidedAPI.
+func (in *IASProvidedAPI) DeepCopy() *IASProvidedAPI {
+	if in == nil {
+		return nil
+	}
+	out := new(IASProvidedAPI)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -17,11 +17,18 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"flag"
+	"net/url"
 	"os"
+	"regexp"
+	"strings"
+	"time"
 
 	klmapiv1beta2 "github.com/kyma-project/lifecycle-manager/api/v1beta2"
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	kutilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	kscheme "k8s.io/client-go/kubernetes/scheme"
 	kcontrollerruntime "sigs.k8s.io/controller-runtime"
@@ -32,6 +39,10 @@ import (
 
 	eamapiv1alpha1 "github.com/kyma-project/eventing-auth-manager/api/v1alpha1"
 	eamcontrollers "github.com/kyma-project/eventing-auth-manager/controllers"
+	"github.com/kyma-project/eventing-auth-manager/internal/audit"
+	eamias "github.com/kyma-project/eventing-auth-manager/internal/ias"
+	"github.com/kyma-project/eventing-auth-manager/internal/logging"
+	"github.com/kyma-project/eventing-auth-manager/internal/tracing"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -40,24 +51,143 @@ import (
 
 func main() {
 	const webhookPort = 9443
+	const tracingShutdownTimeout = 5 * time.Second
 	setupLog := kcontrollerruntime.Log.WithName("setup")
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
 	var globalAccountID string
+	var instanceID string
+	var duplicateApplicationPolicy string
+	var deletionDeadline time.Duration
+	var orphanCollectionInterval time.Duration
+	var orphanCollectionGracePeriod time.Duration
+	var orphanCollectionDryRun bool
+	var orphanCollectionNamePattern string
+	var orphanCollectionDescriptionPattern string
+	var iasReadinessCacheTTL time.Duration
+	var eventingAuthMaxConcurrentReconciles int
+	var kymaMaxConcurrentReconciles int
+	var kymaPropagatedLabels string
+	var kymaOptOutLabel string
+	var iasRateLimitQPS float64
+	var iasRateLimitBurst int
+	var iasProxyURL string
+	var iasCABundleSecret string
+	iasTransport := eamias.DefaultTransportOptions()
+	var tracingOptions tracing.Options
+	var auditLogPath string
+	componentLogLevels := logging.ComponentLevels{}
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.StringVar(&globalAccountID, "ias-global-account-id", "", "The global account id to be configured in the created IAS application")
+	flag.StringVar(&instanceID, "instance-id", "default",
+		"The ID of the operator instance recorded as owner in the created IAS applications. Applications of other instances are never modified or deleted.")
+	flag.StringVar(&duplicateApplicationPolicy, "ias-duplicate-application-policy", string(eamias.DuplicatePolicyFail),
+		"The policy to resolve multiple IAS applications with the same name. One of fail, keep-newest, keep-matching-status-uuid. "+
+			"Can be overridden per EventingAuth CR with the annotation "+eamapiv1alpha1.AnnotationDuplicateApplicationPolicy+".")
+	flag.DurationVar(&deletionDeadline, "deletion-deadline", 0,
+		"The duration after the deletion of an EventingAuth CR after which its finalizer is removed even if the IAS application or "+
+			"the secret can't be deleted. A value of 0 disables the deadline. The finalizer can also be removed with the annotation "+
+			eamapiv1alpha1.AnnotationForceDelete+"=true.")
+	flag.DurationVar(&orphanCollectionInterval, "orphan-collection-interval", time.Hour,
+		"The interval in which orphaned IAS applications are collected. A value of 0 disables the collection.")
+	flag.DurationVar(&orphanCollectionGracePeriod, "orphan-collection-grace-period", 24*time.Hour,
+		"The minimum age of an IAS application without EventingAuth CR before it is collected.")
+	flag.BoolVar(&orphanCollectionDryRun, "orphan-collection-dry-run", true,
+		"Only report orphaned IAS applications instead of deleting them.")
+	flag.StringVar(&orphanCollectionNamePattern, "orphan-collection-name-pattern", eamcontrollers.DefaultOrphanedApplicationNamePattern,
+		"The regular expression matching the names of the IAS applications created by the operator.")
+	flag.StringVar(&orphanCollectionDescriptionPattern, "orphan-collection-description-pattern", "",
+		"The regular expression matching the descriptions of the IAS applications created by the operator.")
+	flag.DurationVar(&iasReadinessCacheTTL, "ias-readiness-cache-ttl", time.Minute,
+		"The duration for which the result of the readiness check probing IAS and its OIDC configuration is cached. "+
+			"A value of 0 probes IAS on every readiness probe.")
+	flag.IntVar(&eventingAuthMaxConcurrentReconciles, "eventing-auth-max-concurrent-reconciles", 1,
+		"The number of EventingAuth CRs that are reconciled in parallel.")
+	flag.IntVar(&kymaMaxConcurrentReconciles, "kyma-max-concurrent-reconciles", 1,
+		"The number of Kyma CRs that are reconciled in parallel.")
+	flag.StringVar(&kymaPropagatedLabels, "kyma-propagated-labels", strings.Join(eamcontrollers.DefaultKymaPropagatedLabels, ","),
+		"The comma-separated keys of the labels that are copied from the Kyma CRs to their EventingAuth CRs.")
+	flag.StringVar(&kymaOptOutLabel, "kyma-opt-out-label", eamcontrollers.DefaultKymaOptOutLabel,
+		"The label of the Kyma CRs that removes the EventingAuth CR if set to \"true\". An empty label disables the opt-out.")
+	flag.Float64Var(&iasRateLimitQPS, "ias-rate-limit-qps", eamias.DefaultRateLimitQPS,
+		"The number of requests per second that are sent to IAS. A value of 0 disables the rate limit.")
+	flag.IntVar(&iasRateLimitBurst, "ias-rate-limit-burst", eamias.DefaultRateLimitBurst,
+		"The number of requests that are sent to IAS at once before the rate limit applies.")
+	flag.DurationVar(&iasTransport.RequestTimeout, "ias-request-timeout", iasTransport.RequestTimeout,
+		"The maximum duration of a request to IAS including reading the response. A value of 0 disables the timeout.")
+	flag.DurationVar(&iasTransport.DialTimeout, "ias-dial-timeout", iasTransport.DialTimeout,
+		"The maximum duration to establish a connection to IAS. A value of 0 disables the timeout.")
+	flag.DurationVar(&iasTransport.TLSHandshakeTimeout, "ias-tls-handshake-timeout", iasTransport.TLSHandshakeTimeout,
+		"The maximum duration of the TLS handshake with IAS. A value of 0 disables the timeout.")
+	flag.DurationVar(&iasTransport.ResponseHeaderTimeout, "ias-response-header-timeout", iasTransport.ResponseHeaderTimeout,
+		"The maximum duration to wait for the response headers of IAS. A value of 0 disables the timeout.")
+	flag.DurationVar(&iasTransport.KeepAlive, "ias-keep-alive", iasTransport.KeepAlive,
+		"The interval of the keep-alive probes of the connections to IAS. A negative value disables the keep-alive probes.")
+	flag.DurationVar(&iasTransport.IdleConnTimeout, "ias-idle-conn-timeout", iasTransport.IdleConnTimeout,
+		"The duration after which an idle connection to IAS is closed. A value of 0 keeps idle connections open.")
+	flag.IntVar(&iasTransport.MaxIdleConns, "ias-max-idle-conns", iasTransport.MaxIdleConns,
+		"The maximum number of idle connections to IAS. A value of 0 means no limit.")
+	flag.IntVar(&iasTransport.MaxIdleConnsPerHost, "ias-max-idle-conns-per-host", iasTransport.MaxIdleConnsPerHost,
+		"The maximum number of idle connections per IAS host.")
+	flag.StringVar(&iasProxyURL, "ias-proxy-url", "",
+		"The URL of the proxy for the requests to IAS. If empty, the proxy is taken from the environment variables HTTPS_PROXY and NO_PROXY.")
+	flag.StringVar(&iasCABundleSecret, "ias-ca-bundle-secret", "",
+		"The secret in the format namespace/name whose key "+eamias.CABundleKey+" contains PEM encoded certificates that are trusted "+
+			"for the requests to IAS in addition to the system certificates.")
+	flag.StringVar(&tracingOptions.OTLPEndpoint, "otlp-endpoint", "",
+		"The host and port of the OTLP/HTTP collector the traces are exported to, e.g. otel-collector.kyma-system:4318. "+
+			"If empty, traces are not exported.")
+	flag.BoolVar(&tracingOptions.Insecure, "otlp-insecure", false, "Export the traces to the OTLP/HTTP collector without TLS.")
+	flag.StringVar(&auditLogPath, "audit-log-path", "",
+		"The file the audit log of the credential lifecycle operations is appended to as JSON lines. Use - to write to stdout. "+
+			"If empty, no audit log is written.")
+	flag.Var(componentLogLevels.Flag(logging.ComponentIAS), "ias-log-level",
+		"The log level of the IAS client. One of debug, info, error, or an integer greater than 0 for increasing verbosity. "+
+			"If not set, the level of --zap-log-level is used.")
+	flag.Var(componentLogLevels.Flag(logging.ComponentSKR), "skr-log-level",
+		"The log level of the client of the managed runtimes. The values are the same as for --ias-log-level.")
+	flag.Var(componentLogLevels.Flag(logging.ComponentControllers), "controllers-log-level",
+		"The log level of the controllers. The values are the same as for --ias-log-level.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	// production defaults log JSON with sampling at info level, --zap-devel switches to the human-readable development mode
 	opts := zap.Options{
-		Development: true,
+		Development: false,
 	}
 	opts.BindFlags(flag.CommandLine)
 	flag.Parse()
 
-	kcontrollerruntime.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
+	kcontrollerruntime.SetLogger(zap.New(zap.UseFlagOptions(&opts), componentLogLevels.Opts()))
+
+	duplicatePolicy, err := eamias.ParseDuplicatePolicy(duplicateApplicationPolicy)
+	if err != nil {
+		setupLog.Error(err, "invalid duplicate application policy")
+		os.Exit(1)
+	}
+	if iasTransport.ProxyURL, err = parseOptionalURL(iasProxyURL); err != nil {
+		setupLog.Error(err, "invalid IAS proxy URL")
+		os.Exit(1)
+	}
+	caBundleSecret, err := parseOptionalNamespacedName(iasCABundleSecret)
+	if err != nil {
+		setupLog.Error(err, "invalid IAS CA bundle secret")
+		os.Exit(1)
+	}
+
+	auditSink, closeAuditLog, err := openAuditSink(auditLogPath)
+	if err != nil {
+		setupLog.Error(err, "unable to open audit log")
+		os.Exit(1)
+	}
+
+	shutdownTracing, err := tracing.Setup(context.Background(), tracingOptions)
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		os.Exit(1)
+	}
 
 	mgr, err := kcontrollerruntime.NewManager(kcontrollerruntime.GetConfigOrDie(), kcontrollerruntime.Options{
 		Scheme:                 initScheme(),
@@ -88,17 +218,64 @@ func main() {
 		os.Exit(1)
 	}
 
-	kymaReconciler := eamcontrollers.NewKymaReconciler(mgr.GetClient(), mgr.GetScheme())
+	kymaReconciler := eamcontrollers.NewKymaReconciler(mgr.GetClient(), mgr.GetScheme(), eamcontrollers.KymaReconcilerOptions{
+		MaxConcurrentReconciles: kymaMaxConcurrentReconciles,
+		PropagatedLabels:        parseList(kymaPropagatedLabels),
+		OptOutLabel:             kymaOptOutLabel,
+	})
 	if err = kymaReconciler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Kyma")
 		os.Exit(1)
 	}
 
-	eventingAuthReconciler := eamcontrollers.NewEventingAuthReconciler(mgr.GetClient(), mgr.GetScheme(), globalAccountID)
+	iasCredentialsReconciler := eamcontrollers.NewIasCredentialsReconciler(mgr.GetClient(), eamias.ClientOptions{
+		RateLimitQPS:     iasRateLimitQPS,
+		RateLimitBurst:   iasRateLimitBurst,
+		Transport:        iasTransport,
+		OperatorIdentity: eamias.OperatorIdentity(instanceID),
+		AuditSink:        auditSink,
+	}, caBundleSecret)
+	if err = iasCredentialsReconciler.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "IasCredentials")
+		os.Exit(1)
+	}
+
+	eventingAuthReconciler := eamcontrollers.NewEventingAuthReconciler(mgr.GetClient(), mgr.GetScheme(), iasCredentialsReconciler,
+		eamcontrollers.EventingAuthReconcilerOptions{
+			GlobalAccountID:         globalAccountID,
+			DuplicatePolicy:         duplicatePolicy,
+			InstanceID:              instanceID,
+			DeletionDeadline:        deletionDeadline,
+			MaxConcurrentReconciles: eventingAuthMaxConcurrentReconciles,
+			AuditSink:               auditSink,
+		})
 	if err = eventingAuthReconciler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "EventingAuth")
 		os.Exit(1)
 	}
+
+	if orphanCollectionInterval > 0 {
+		collectorConfig := eamcontrollers.OrphanedApplicationCollectorConfig{
+			Interval:        orphanCollectionInterval,
+			GracePeriod:     orphanCollectionGracePeriod,
+			DryRun:          orphanCollectionDryRun,
+			GlobalAccountID: globalAccountID,
+			InstanceID:      instanceID,
+		}
+		if collectorConfig.NamePattern, err = compileOptionalPattern(orphanCollectionNamePattern); err != nil {
+			setupLog.Error(err, "invalid orphan collection name pattern")
+			os.Exit(1)
+		}
+		if collectorConfig.DescriptionPattern, err = compileOptionalPattern(orphanCollectionDescriptionPattern); err != nil {
+			setupLog.Error(err, "invalid orphan collection description pattern")
+			os.Exit(1)
+		}
+		orphanedApplicationCollector := eamcontrollers.NewOrphanedApplicationCollector(mgr.GetClient(), iasCredentialsReconciler, collectorConfig)
+		if err = mgr.Add(orphanedApplicationCollector); err != nil {
+			setupLog.Error(err, "unable to set up orphaned IAS application collector")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -109,10 +286,27 @@ func main() {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	if err := mgr.AddReadyzCheck("ias-credentials", iasCredentialsReconciler.ReadyzCheck); err != nil {
+		setupLog.Error(err, "unable to set up IAS credentials ready check")
+		os.Exit(1)
+	}
+	iasReadinessChecker := eamcontrollers.NewIasReadinessChecker(iasCredentialsReconciler, iasReadinessCacheTTL)
+	if err := mgr.AddReadyzCheck("ias", iasReadinessChecker.Check); err != nil {
+		setupLog.Error(err, "unable to set up IAS ready check")
+		os.Exit(1)
+	}
 
 	setupLog.Info("starting manager")
-	if err := mgr.Start(kcontrollerruntime.SetupSignalHandler()); err != nil {
-		setupLog.Error(err, "problem running manager")
+	startErr := mgr.Start(kcontrollerruntime.SetupSignalHandler())
+	// the spans of the last reconciliations are flushed before the operator exits
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		setupLog.Error(err, "problem shutting down tracing")
+	}
+	cancel()
+	closeAuditLog()
+	if startErr != nil {
+		setupLog.Error(startErr, "problem running manager")
 		os.Exit(1)
 	}
 }
@@ -124,3 +318,66 @@ func initScheme() *runtime.Scheme {
 	kutilruntime.Must(eamapiv1alpha1.AddToScheme(scheme))
 	return scheme
 }
+
+func parseOptionalURL(rawURL string) (*url.URL, error) {
+	if rawURL == "" {
+		return nil, nil //nolint:nilnil
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, errors.Errorf("URL %q must contain a scheme and a host", rawURL)
+	}
+	return u, nil
+}
+
+func parseOptionalNamespacedName(namespacedName string) (types.NamespacedName, error) {
+	if namespacedName == "" {
+		return types.NamespacedName{}, nil
+	}
+	namespace, name, found := strings.Cut(namespacedName, "/")
+	if !found || namespace == "" || name == "" || strings.Contains(name, "/") {
+		return types.NamespacedName{}, errors.Errorf("%q must be in the format namespace/name", namespacedName)
+	}
+	return types.NamespacedName{Namespace: namespace, Name: name}, nil
+}
+
+// parseList splits the comma-separated list and drops empty entries.
+func parseList(list string) []string {
+	var entries []string
+	for _, entry := range strings.Split(list, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// openAuditSink returns the sink writing the audit log to the file with the given path or to stdout for "-", and the function closing
+// the file. For an empty path, no sink is returned and the audit log is disabled.
+func openAuditSink(path string) (audit.Sink, func(), error) {
+	onError := func(err error, record audit.Record) {
+		kcontrollerruntime.Log.WithName("audit").Error(err, "failed to write audit record", "operation", record.Operation,
+			"runtimeId", record.RuntimeID, "applicationId", record.ApplicationID, "outcome", record.Outcome)
+	}
+	switch path {
+	case "":
+		return nil, func() {}, nil
+	case "-":
+		return audit.NewJSONLinesSink(os.Stdout, onError), func() {}, nil
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, nil, err
+	}
+	return audit.NewJSONLinesSink(file, onError), func() { _ = file.Close() }, nil
+}
+
+func compileOptionalPattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil //nolint:nilnil
+	}
+	return regexp.Compile(pattern)
+}
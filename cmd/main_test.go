@@ -1,12 +1,88 @@
 package main
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kyma-project/eventing-auth-manager/internal/audit"
 )
 
 func Test_initScheme(t *testing.T) {
 	scheme := initScheme()
 	require.NotNil(t, scheme)
 }
+
+func Test_compileOptionalPattern(t *testing.T) {
+	pattern, err := compileOptionalPattern("")
+	require.NoError(t, err)
+	require.Nil(t, pattern)
+
+	pattern, err = compileOptionalPattern("^[a-z]+$")
+	require.NoError(t, err)
+	require.True(t, pattern.MatchString("runtime"))
+
+	_, err = compileOptionalPattern("[")
+	require.Error(t, err)
+}
+
+func Test_parseOptionalURL(t *testing.T) {
+	u, err := parseOptionalURL("")
+	require.NoError(t, err)
+	require.Nil(t, u)
+
+	u, err = parseOptionalURL("http://proxy.example.com:3128")
+	require.NoError(t, err)
+	require.Equal(t, "proxy.example.com:3128", u.Host)
+
+	_, err = parseOptionalURL("proxy.example.com")
+	require.Error(t, err)
+}
+
+func Test_parseOptionalNamespacedName(t *testing.T) {
+	name, err := parseOptionalNamespacedName("")
+	require.NoError(t, err)
+	require.Equal(t, types.NamespacedName{}, name)
+
+	name, err = parseOptionalNamespacedName("kcp-system/ias-ca-bundle")
+	require.NoError(t, err)
+	require.Equal(t, types.NamespacedName{Namespace: "kcp-system", Name: "ias-ca-bundle"}, name)
+
+	for _, invalid := range []string{"ias-ca-bundle", "/ias-ca-bundle", "kcp-system/", "kcp-system/ias/ca-bundle"} {
+		_, err = parseOptionalNamespacedName(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func Test_parseList(t *testing.T) {
+	require.Nil(t, parseList(""))
+	require.Equal(t, []string{"kyma-project.io/region"}, parseList("kyma-project.io/region"))
+	require.Equal(t, []string{"kyma-project.io/global-account-id", "kyma-project.io/region"},
+		parseList(" kyma-project.io/global-account-id,, kyma-project.io/region "))
+}
+
+func Test_openAuditSink(t *testing.T) {
+	sink, closeAuditLog, err := openAuditSink("")
+	require.NoError(t, err)
+	require.Nil(t, sink)
+	closeAuditLog()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	require.NoError(t, os.WriteFile(path, []byte("{}\n"), 0o600))
+	sink, closeAuditLog, err = openAuditSink(path)
+	require.NoError(t, err)
+	sink.Record(audit.NewRecord(audit.OperationCreateSecret, "eventing-auth-manager/default", "runtime-id", "app-id", nil))
+	closeAuditLog()
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(t, lines, 2)
+	require.Contains(t, lines[1], `"operation":"create-secret"`)
+
+	_, _, err = openAuditSink(filepath.Join(t.TempDir(), "missing", "audit.log"))
+	require.Error(t, err)
+}
@@ -19,21 +19,33 @@ package controllers
 import (
 	"context"
 	"fmt"
-	"os"
-	"reflect"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	kcorev1 "k8s.io/api/core/v1"
+	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	kcontrollerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	kpkgclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	eamapiv1alpha1 "github.com/kyma-project/eventing-auth-manager/api/v1alpha1"
+	"github.com/kyma-project/eventing-auth-manager/internal/audit"
 	eamias "github.com/kyma-project/eventing-auth-manager/internal/ias"
+	"github.com/kyma-project/eventing-auth-manager/internal/logging"
+	eammetrics "github.com/kyma-project/eventing-auth-manager/internal/metrics"
+	"github.com/kyma-project/eventing-auth-manager/internal/redact"
 	"github.com/kyma-project/eventing-auth-manager/internal/skr"
+	"github.com/kyma-project/eventing-auth-manager/internal/tracing"
 )
 
 const (
@@ -42,24 +54,62 @@ const (
 	iasCredsSecretName           string = "IAS_CREDS_SECRET_NAME"
 	defaultIasCredsNamespaceName string = "kcp-system"
 	DefaultIasCredsSecretName    string = "eventing-auth-ias-creds" //nolint:gosec
+	eventRecorderName            string = "eventing-auth-manager"
+	// lastSuccessfulSyncTimeResolution is the minimum duration between two updates of the last successful sync time.
+	lastSuccessfulSyncTimeResolution = time.Minute
 )
 
+// Reasons of the events recorded for an EventingAuth.
+const (
+	eventReasonDeleted         = "Deleted"
+	eventReasonOrphaned        = "Orphaned"
+	eventReasonSecretOrphaned  = "SecretOrphaned"
+	eventReasonDeletionFailed  = "DeletionFailed"
+	eventReasonResourcesLeaked = "ResourcesLeaked"
+	// eventReasonCredentialsRegenerated is recorded when new credentials are issued on request of the regenerate-credentials annotation.
+	eventReasonCredentialsRegenerated = "CredentialsRegenerated"
+	// eventReasonCredentialsRenewed is recorded when new credentials are issued because the credential type changed or the client
+	// certificate expires soon.
+	eventReasonCredentialsRenewed = "CredentialsRenewed"
+)
+
+// EventingAuthReconcilerOptions contains the configuration of the EventingAuth reconciler.
+type EventingAuthReconcilerOptions struct {
+	// GlobalAccountID is configured in the created IAS applications.
+	GlobalAccountID string
+	// DuplicatePolicy resolves multiple IAS applications with the same name unless overridden by the annotation of the CR.
+	DuplicatePolicy eamias.DuplicatePolicy
+	// InstanceID identifies the operator instance in the ownership recorded in the created IAS applications.
+	InstanceID string
+	// DeletionDeadline is the duration after the deletion timestamp of a CR after which the finalizer is removed even if the clean-up
+	// fails. Zero disables the deadline.
+	DeletionDeadline time.Duration
+	// MaxConcurrentReconciles is the number of EventingAuth CRs that are reconciled in parallel. Values below 1 use the default of
+	// controller-runtime, which is a single worker.
+	MaxConcurrentReconciles int
+	// AuditSink receives the records of the creation, update and deletion of the secrets with the credentials on the managed runtimes.
+	// If nil, no records are written.
+	AuditSink audit.Sink
+}
+
 // eventingAuthReconciler reconciles a EventingAuth object.
 type eventingAuthReconciler struct {
 	kpkgclient.Client
-	Scheme          *runtime.Scheme
-	iasClient       eamias.Client
-	globalAccountID string
+	Scheme         *runtime.Scheme
+	iasCredentials *IasCredentialsReconciler
+	options        EventingAuthReconcilerOptions
+	recorder       record.EventRecorder
 	// existingIasApplications stores existing IAS apps in memory not to recreate again if exists
-	existingIasApplications map[string]eamias.Application
+	existingIasApplications *iasApplicationCache
 }
 
-func NewEventingAuthReconciler(c kpkgclient.Client, s *runtime.Scheme, globalAccountID string) ManagedReconciler {
+func NewEventingAuthReconciler(c kpkgclient.Client, s *runtime.Scheme, iasCredentials *IasCredentialsReconciler, options EventingAuthReconcilerOptions) ManagedReconciler {
 	return &eventingAuthReconciler{
 		Client:                  c,
 		Scheme:                  s,
-		globalAccountID:         globalAccountID,
-		existingIasApplications: map[string]eamias.Application{},
+		iasCredentials:          iasCredentials,
+		options:                 options,
+		existingIasApplications: newIasApplicationCache(),
 	}
 }
 
@@ -67,18 +117,35 @@ func NewEventingAuthReconciler(c kpkgclient.Client, s *runtime.Scheme, globalAcc
 // +kubebuilder:rbac:groups=operator.kyma-project.io,resources=eventingauths/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=operator.kyma-project.io,resources=eventingauths/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=watch,list
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 func (r *eventingAuthReconciler) Reconcile(ctx context.Context, req kcontrollerruntime.Request) (kcontrollerruntime.Result, error) {
-	logger := log.FromContext(ctx)
+	// the name of the CR is the runtime ID
+	logger := logging.FromContext(ctx, logging.ComponentControllers).WithValues(logging.KeyRuntimeID, req.Name)
+	ctx = log.IntoContext(ctx, logger)
 	logger.Info("Reconciling EventingAuth")
 
 	cr, err := fetchEventingAuth(ctx, r.Client, req.NamespacedName)
 	if err != nil {
 		return kcontrollerruntime.Result{}, kpkgclient.IgnoreNotFound(err)
 	}
+	if cr.Status.Application != nil && cr.Status.Application.UUID != "" {
+		ctx, logger = withApplicationID(ctx, logger, cr.Status.Application.UUID)
+	}
 
-	// sync IAS client credentials
-	r.iasClient, err = r.getIasClient()
-	if err != nil {
+	// a paused CR is neither changed in IAS nor on the managed runtime, and its deletion is deferred until it is resumed
+	paused, err := r.handlePause(ctx, logger, &cr)
+	if err != nil || paused {
+		return kcontrollerruntime.Result{}, err
+	}
+
+	// the IAS client is rebuilt by the IAS credentials reconciler whenever the IAS credentials secret changes
+	iasClient, err := r.iasCredentials.GetIasClient()
+	if err != nil && r.forceDeletionReason(&cr) == "" {
+		// the credential problem is reported before the finalizer is added, so that the CR doesn't stay without status
+		if statusErr := r.reportIasClientError(ctx, &cr, err); statusErr != nil {
+			return kcontrollerruntime.Result{}, statusErr
+		}
 		return kcontrollerruntime.Result{}, err
 	}
 	// check DeletionTimestamp to determine if object is under deletion
@@ -88,29 +155,52 @@ func (r *eventingAuthReconciler) Reconcile(ctx context.Context, req kcontrollerr
 		}
 	} else {
 		logger.Info("Handling deletion")
-		if err = r.handleDeletion(ctx, r.iasClient, &cr); err != nil {
+		if err = r.handleDeletion(ctx, iasClient, &cr); err != nil {
+			if r.recordDuplicateApplications(ctx, logger, iasClient, &cr, err) {
+				if statusErr := r.syncEventingAuthStatus(ctx, &cr); statusErr != nil {
+					return kcontrollerruntime.Result{}, statusErr
+				}
+			}
 			return kcontrollerruntime.Result{}, err
 		}
 		// Stop reconciliation as the item is being deleted
 		return kcontrollerruntime.Result{}, nil
 	}
 
-	return r.handleApplicationSecret(ctx, logger, cr)
+	return r.handleApplicationSecret(ctx, logger, iasClient, cr)
 }
 
-func (r *eventingAuthReconciler) handleApplicationSecret(ctx context.Context, logger logr.Logger, cr eamapiv1alpha1.EventingAuth) (kcontrollerruntime.Result, error) {
+func (r *eventingAuthReconciler) handleApplicationSecret(ctx context.Context, logger logr.Logger, iasClient eamias.Client, cr eamapiv1alpha1.EventingAuth) (kcontrollerruntime.Result, error) {
 	skrClient, err := skr.NewClient(r.Client, cr.Name)
 	if err != nil {
 		logger.Error(err, "Failed to retrieve client of target cluster")
+		if statusErr := r.updateEventingAuthStatus(ctx, &cr, eamapiv1alpha1.ConditionSecretReady, err); statusErr != nil {
+			return kcontrollerruntime.Result{}, statusErr
+		}
 		return kcontrollerruntime.Result{}, err
 	}
 
-	appSecretExists, err := skrClient.HasApplicationSecret(ctx)
+	existingSecret, err := skrClient.GetApplicationSecret(ctx)
 	if err != nil {
 		logger.Error(err, "Failed to retrieve secret state from target cluster")
+		if statusErr := r.updateEventingAuthStatus(ctx, &cr, eamapiv1alpha1.ConditionSecretReady, err); statusErr != nil {
+			return kcontrollerruntime.Result{}, statusErr
+		}
 		return kcontrollerruntime.Result{}, err
 	}
-	if appSecretExists {
+	if existingSecret != nil {
+		if pendingCredentialsRegeneration(&cr) {
+			return kcontrollerruntime.Result{}, r.regenerateCredentials(ctx, logger, iasClient, skrClient, &cr, "")
+		}
+		if reason := eamias.CredentialsRenewalReason(*existingSecret, iasCredentialType(cr), time.Now()); reason != "" {
+			return kcontrollerruntime.Result{}, r.regenerateCredentials(ctx, logger, iasClient, skrClient, &cr, reason)
+		}
+
+		// roll out changes of the application template to the existing application
+		if err := r.updateApplication(ctx, logger, iasClient, &cr); err != nil {
+			return kcontrollerruntime.Result{}, err
+		}
+
 		logger.Info("Reconciliation done, Application secret already exists")
 
 		// sync CR status.
@@ -126,6 +216,8 @@ func (r *eventingAuthReconciler) handleApplicationSecret(ctx context.Context, lo
 		}
 
 		// update ConditionSecretReady and sync status.
+		recordHandledRequests(&cr, false)
+		recordSuccessfulSync(&cr)
 		if err := r.updateEventingAuthStatus(ctx, &cr, eamapiv1alpha1.ConditionSecretReady, nil); err != nil {
 			return kcontrollerruntime.Result{}, err
 		}
@@ -133,20 +225,30 @@ func (r *eventingAuthReconciler) handleApplicationSecret(ctx context.Context, lo
 		return kcontrollerruntime.Result{}, nil
 	}
 
-	iasApplication, appExists := r.existingIasApplications[cr.Name]
+	iasApplication, appExists := r.existingIasApplications.get(cr.Name)
 	if !appExists {
+		appConfig, err := r.desiredApplicationConfig(ctx, cr)
+		if err != nil {
+			if err := r.updateEventingAuthStatus(ctx, &cr, eamapiv1alpha1.ConditionApplicationReady, err); err != nil {
+				return kcontrollerruntime.Result{}, err
+			}
+			return kcontrollerruntime.Result{}, err
+		}
+
 		var createAppErr error
 		logger.Info("Creating application in IAS")
-		iasApplication, createAppErr = r.iasClient.CreateApplication(ctx, cr.Name, r.globalAccountID)
+		iasApplication, createAppErr = iasClient.CreateApplication(ctx, cr.Name, appConfig)
 		if createAppErr != nil {
 			logger.Error(createAppErr, "Failed to create application in IAS")
+			r.recordDuplicateApplications(ctx, logger, iasClient, &cr, createAppErr)
 			if err := r.updateEventingAuthStatus(ctx, &cr, eamapiv1alpha1.ConditionApplicationReady, createAppErr); err != nil {
 				return kcontrollerruntime.Result{}, err
 			}
 			return kcontrollerruntime.Result{}, createAppErr
 		}
+		ctx, logger = withApplicationID(ctx, logger, iasApplication.GetID())
 		logger.Info("Successfully created application in IAS")
-		r.existingIasApplications[cr.Name] = iasApplication
+		r.existingIasApplications.set(cr.Name, iasApplication)
 	}
 	cr.Status.Application = &eamapiv1alpha1.IASApplication{
 		Name: cr.Name,
@@ -158,6 +260,7 @@ func (r *eventingAuthReconciler) handleApplicationSecret(ctx context.Context, lo
 
 	logger.Info("Creating application secret on SKR")
 	appSecret, createSecretErr := skrClient.CreateSecret(ctx, iasApplication)
+	r.audit(audit.OperationCreateSecret, cr.Name, iasApplication.GetID(), createSecretErr)
 	if createSecretErr != nil {
 		logger.Error(createSecretErr, "Failed to create application secret on SKR")
 		if err := r.updateEventingAuthStatus(ctx, &cr, eamapiv1alpha1.ConditionSecretReady, createSecretErr); err != nil {
@@ -168,12 +271,15 @@ func (r *eventingAuthReconciler) handleApplicationSecret(ctx context.Context, lo
 	logger.Info("Successfully created application secret on SKR")
 
 	// Because the application secret is created on the SKR, we can delete it from the cache.
-	delete(r.existingIasApplications, cr.Name)
+	r.existingIasApplications.delete(cr.Name)
 
 	cr.Status.AuthSecret = &eamapiv1alpha1.AuthSecret{
 		ClusterID:      cr.Name,
 		NamespacedName: fmt.Sprintf("%s/%s", appSecret.Namespace, appSecret.Name),
 	}
+	// the created secret contains new credentials, which also handles a pending request to regenerate the credentials
+	recordHandledRequests(&cr, true)
+	recordSuccessfulSync(&cr)
 	if err := r.updateEventingAuthStatus(ctx, &cr, eamapiv1alpha1.ConditionSecretReady, nil); err != nil {
 		return kcontrollerruntime.Result{}, err
 	}
@@ -182,40 +288,31 @@ func (r *eventingAuthReconciler) handleApplicationSecret(ctx context.Context, lo
 	return kcontrollerruntime.Result{}, nil
 }
 
-func (r *eventingAuthReconciler) getIasClient() (eamias.Client, error) {
-	namespace, name := getIasSecretNamespaceAndNameConfigs()
-	newIasCredentials, err := eamias.ReadCredentials(namespace, name, r.Client)
-	if err != nil {
-		return nil, err
-	}
-	// return from cache unless credentials are changed
-	if r.iasClient != nil && reflect.DeepEqual(r.iasClient.GetCredentials(), newIasCredentials) {
-		return r.iasClient, nil
+// updateApplication patches the settings of the existing IAS application if they differ from the desired application config.
+func (r *eventingAuthReconciler) updateApplication(ctx context.Context, logger logr.Logger, iasClient eamias.Client, cr *eamapiv1alpha1.EventingAuth) error {
+	appConfig, err := r.desiredApplicationConfig(ctx, *cr)
+	if err == nil {
+		var updated bool
+		updated, err = iasClient.UpdateApplication(ctx, cr.Name, appConfig)
+		if updated {
+			logger.Info("Successfully updated application settings in IAS")
+		}
 	}
-	// update IAS client if credentials are changed
-	iasClient, err := eamias.NewClient(newIasCredentials.URL, newIasCredentials.Username, newIasCredentials.Password)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to create a new IAS client")
-	}
-	return iasClient, nil
-}
-
-func getIasSecretNamespaceAndNameConfigs() (string, string) {
-	namespace := os.Getenv(iasCredsSecretNamespace)
-	if len(namespace) == 0 {
-		namespace = defaultIasCredsNamespaceName
-	}
-	name := os.Getenv(iasCredsSecretName)
-	if len(name) == 0 {
-		name = DefaultIasCredsSecretName
+		logger.Error(err, "Failed to update application in IAS")
+		r.recordDuplicateApplications(ctx, logger, iasClient, cr, err)
+		if statusErr := r.updateEventingAuthStatus(ctx, cr, eamapiv1alpha1.ConditionApplicationReady, err); statusErr != nil {
+			return statusErr
+		}
+		return err
 	}
-	return namespace, name
+	return nil
 }
 
 // Adds the finalizer if none exists.
 func (r *eventingAuthReconciler) addFinalizer(ctx context.Context, cr *eamapiv1alpha1.EventingAuth) error {
 	if !controllerutil.ContainsFinalizer(cr, eventingAuthFinalizerName) {
-		kcontrollerruntime.Log.Info("Adding finalizer", "eventingAuth", cr.Name, "eventingAuthNamespace", cr.Namespace)
+		log.FromContext(ctx).Info("Adding finalizer")
 		controllerutil.AddFinalizer(cr, eventingAuthFinalizerName)
 		if err := r.Update(ctx, cr); err != nil {
 			return errors.Wrap(err, "failed to add finalizer")
@@ -228,19 +325,23 @@ func (r *eventingAuthReconciler) addFinalizer(ctx context.Context, cr *eamapiv1a
 func (r *eventingAuthReconciler) handleDeletion(ctx context.Context, iasClient eamias.Client, cr *eamapiv1alpha1.EventingAuth) error {
 	// The object is being deleted
 	if controllerutil.ContainsFinalizer(cr, eventingAuthFinalizerName) {
-		// delete IAS application clean-up
-		if err := iasClient.DeleteApplication(ctx, cr.Name); err != nil {
-			return errors.Wrap(err, "failed to delete IAS Application")
-		}
-		kcontrollerruntime.Log.Info("Deleted IAS application",
-			"eventingAuth", cr.Name, "namespace", cr.Namespace)
-
-		if err := r.deleteK8sSecretOnSkr(ctx, cr); err != nil {
-			return err
+		forceReason := r.forceDeletionReason(cr)
+		leaked, err := r.cleanUp(ctx, iasClient, cr, forceReason != "")
+		if err != nil {
+			r.recorder.Eventf(cr, kcorev1.EventTypeWarning, eventReasonDeletionFailed,
+				"Failed to clean up with deletion policy %s: %v", cr.Spec.GetDeletionPolicy(), err)
+			if forceReason == "" {
+				cr.Status.Conditions = eamapiv1alpha1.MakeDeletionBlockedCondition(cr, eamapiv1alpha1.ConditionReasonCleanUpFailed, redact.String(err.Error()))
+				if statusErr := r.syncEventingAuthStatus(ctx, cr); statusErr != nil {
+					return statusErr
+				}
+				return err
+			}
+			r.recordLeakedResources(ctx, cr, forceReason, leaked)
 		}
 
 		// delete the app from the cache
-		delete(r.existingIasApplications, cr.Name)
+		r.existingIasApplications.delete(cr.Name)
 
 		// remove our finalizer from the list and update it.
 		controllerutil.RemoveFinalizer(cr, eventingAuthFinalizerName)
@@ -251,6 +352,86 @@ func (r *eventingAuthReconciler) handleDeletion(ctx context.Context, iasClient e
 	return nil
 }
 
+// cleanUp deletes or orphans the IAS application and the secret on the managed runtime according to the deletion policy. With
+// best effort, the clean-up continues after a failure and the resources that could not be deleted are returned with the first error.
+func (r *eventingAuthReconciler) cleanUp(ctx context.Context, iasClient eamias.Client, cr *eamapiv1alpha1.EventingAuth, bestEffort bool) ([]string, error) {
+	policy := cr.Spec.GetDeletionPolicy()
+	switch policy {
+	case eamapiv1alpha1.DeletionPolicyOrphan:
+		// the ownership of the kept application is released, so that it isn't collected as orphan and can be adopted
+		if err := r.releaseIasApplication(ctx, iasClient, cr); err != nil {
+			if !bestEffort {
+				return nil, err
+			}
+			return []string{eammetrics.ResourceIasApplication}, err
+		}
+		log.FromContext(ctx).Info("Orphaned IAS application and SKR k8s secret")
+		r.recorder.Event(cr, kcorev1.EventTypeNormal, eventReasonOrphaned,
+			"Kept IAS application and secret on the managed runtime as the deletion policy is Orphan")
+		return nil, nil
+	case eamapiv1alpha1.DeletionPolicyDelete, eamapiv1alpha1.DeletionPolicyOrphanSecretOnly:
+	default:
+		return nil, errors.Errorf("unsupported deletion policy %s", policy)
+	}
+
+	var leaked []string
+	var cleanUpErr error
+	if err := r.deleteIasApplication(ctx, iasClient, cr); err != nil {
+		if !bestEffort {
+			return nil, err
+		}
+		leaked = append(leaked, eammetrics.ResourceIasApplication)
+		cleanUpErr = err
+	}
+
+	if policy == eamapiv1alpha1.DeletionPolicyOrphanSecretOnly {
+		if cleanUpErr == nil {
+			r.recorder.Event(cr, kcorev1.EventTypeNormal, eventReasonSecretOrphaned,
+				"Deleted IAS application and kept secret on the managed runtime as the deletion policy is OrphanSecretOnly")
+		}
+		return leaked, cleanUpErr
+	}
+
+	if err := r.deleteK8sSecretOnSkr(ctx, cr); err != nil {
+		if !bestEffort {
+			return nil, err
+		}
+		leaked = append(leaked, eammetrics.ResourceSecret)
+		if cleanUpErr == nil {
+			cleanUpErr = err
+		}
+	}
+	if cleanUpErr == nil {
+		r.recorder.Event(cr, kcorev1.EventTypeNormal, eventReasonDeleted,
+			"Deleted IAS application and secret on the managed runtime")
+	}
+	return leaked, cleanUpErr
+}
+
+func (r *eventingAuthReconciler) deleteIasApplication(ctx context.Context, iasClient eamias.Client, cr *eamapiv1alpha1.EventingAuth) error {
+	// the IAS client is missing if the deletion is forced while no IAS client could be built from the IAS credentials
+	if iasClient == nil {
+		return errIasClientNotInitialized
+	}
+	if err := iasClient.DeleteApplication(ctx, cr.Name, r.applicationOwner(*cr)); err != nil {
+		return errors.Wrap(err, "failed to delete IAS Application")
+	}
+	log.FromContext(ctx).Info("Deleted IAS application")
+	return nil
+}
+
+func (r *eventingAuthReconciler) releaseIasApplication(ctx context.Context, iasClient eamias.Client, cr *eamapiv1alpha1.EventingAuth) error {
+	// the IAS client is missing if the deletion is forced while no IAS client could be built from the IAS credentials
+	if iasClient == nil {
+		return errIasClientNotInitialized
+	}
+	if err := iasClient.ReleaseApplication(ctx, cr.Name, r.applicationOwner(*cr)); err != nil {
+		return errors.Wrap(err, "failed to release IAS Application")
+	}
+	log.FromContext(ctx).Info("Released IAS application")
+	return nil
+}
+
 func (r *eventingAuthReconciler) deleteK8sSecretOnSkr(ctx context.Context, eventingAuth *eamapiv1alpha1.EventingAuth) error {
 	skrClient, err := skr.NewClient(r.Client, eventingAuth.Name)
 	if err != nil {
@@ -258,21 +439,68 @@ func (r *eventingAuthReconciler) deleteK8sSecretOnSkr(ctx context.Context, event
 		return kpkgclient.IgnoreNotFound(err)
 	}
 	err = skrClient.DeleteSecret(ctx)
+	r.audit(audit.OperationDeleteSecret, eventingAuth.Name, r.applicationOwner(*eventingAuth).ApplicationID, err)
 	if err != nil {
 		return err
 	}
-	kcontrollerruntime.Log.Info("Deleted SKR k8s secret",
-		"eventingAuth", eventingAuth.Name, "namespace", eventingAuth.Namespace)
+	log.FromContext(ctx).Info("Deleted SKR k8s secret")
 	return nil
 }
 
+// withApplicationID adds the ID of the IAS application to the logger of the reconciliation, so that the logs of the IAS and SKR
+// clients can be correlated with the application.
+func withApplicationID(ctx context.Context, logger logr.Logger, applicationID string) (context.Context, logr.Logger) {
+	logger = logger.WithValues(logging.KeyApplicationID, applicationID)
+	return log.IntoContext(ctx, logger), logger
+}
+
+// audit records an operation on the secret with the credentials on the managed runtime in the audit log.
+func (r *eventingAuthReconciler) audit(operation audit.Operation, runtimeID, applicationID string, err error) {
+	if r.options.AuditSink == nil {
+		return
+	}
+	r.options.AuditSink.Record(audit.NewRecord(operation, eamias.OperatorIdentity(r.options.InstanceID), runtimeID, applicationID, err))
+}
+
+// reportIasClientError records in the status why the IAS client is not available. For a deleted CR, the DeletionBlocked condition is
+// set instead of failing the IASApplicationReady condition.
+func (r *eventingAuthReconciler) reportIasClientError(ctx context.Context, cr *eamapiv1alpha1.EventingAuth, err error) error {
+	if cr.DeletionTimestamp.IsZero() {
+		return r.updateEventingAuthStatus(ctx, cr, eamapiv1alpha1.ConditionApplicationReady, err)
+	}
+	reason := failureReason(err)
+	if reason == "" {
+		reason = eamapiv1alpha1.ConditionReasonFailed
+	}
+	cr.Status.Conditions = eamapiv1alpha1.MakeDeletionBlockedCondition(cr, reason, redact.String(err.Error()))
+	return r.syncEventingAuthStatus(ctx, cr)
+}
+
 // updateEventingAuthStatus updates the subscription's status changes to k8s.
 func (r *eventingAuthReconciler) updateEventingAuthStatus(ctx context.Context, cr *eamapiv1alpha1.EventingAuth, conditionType eamapiv1alpha1.ConditionType, errToCheck error) error {
-	_, err := eamapiv1alpha1.UpdateConditionAndState(cr, conditionType, errToCheck)
+	// the condition message is visible to everyone who can read the CR, so secrets in the error message are masked
+	errToCheck = redact.Error(errToCheck)
+	_, err := eamapiv1alpha1.UpdateConditionAndState(cr, conditionType,
+		eamapiv1alpha1.NewConditionReasonError(failureReason(errToCheck), errToCheck))
 	if err != nil {
 		return err
 	}
+	return r.syncEventingAuthStatus(ctx, cr)
+}
+
+// recordSuccessfulSync records the time of the successful reconciliation in the status. The time is only updated after
+// lastSuccessfulSyncTimeResolution, because each status update triggers another reconciliation.
+func recordSuccessfulSync(cr *eamapiv1alpha1.EventingAuth) {
+	now := time.Now()
+	if cr.Status.LastSuccessfulSyncTime != nil && now.Sub(cr.Status.LastSuccessfulSyncTime.Time) < lastSuccessfulSyncTimeResolution {
+		return
+	}
+	syncTime := kmetav1.NewTime(now)
+	cr.Status.LastSuccessfulSyncTime = &syncTime
+}
 
+// syncEventingAuthStatus syncs the status of the given EventingAuth to k8s.
+func (r *eventingAuthReconciler) syncEventingAuthStatus(ctx context.Context, cr *eamapiv1alpha1.EventingAuth) error {
 	namespacedName := &types.NamespacedName{
 		Name:      cr.Name,
 		Namespace: cr.Namespace,
@@ -289,7 +517,7 @@ func (r *eventingAuthReconciler) updateEventingAuthStatus(ctx context.Context, c
 	desiredEventingAuth.Status = cr.Status
 
 	// sync EventingAuth status with k8s
-	if err = r.updateStatus(ctx, actualEventingAuth, desiredEventingAuth); err != nil {
+	if err := r.updateStatus(ctx, actualEventingAuth, desiredEventingAuth); err != nil {
 		return errors.Wrap(err, "failed to update EventingAuth status")
 	}
 
@@ -315,9 +543,14 @@ func fetchEventingAuth(ctx context.Context, c kpkgclient.Client, name types.Name
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *eventingAuthReconciler) SetupWithManager(mgr kcontrollerruntime.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor(eventRecorderName)
 	return kcontrollerruntime.NewControllerManagedBy(mgr).
 		For(&eamapiv1alpha1.EventingAuth{}).
-		Complete(r)
+		WatchesRawSource(source.Channel(r.iasCredentials.EventingAuthEvents(), &handler.EnqueueRequestForObject{})).
+		Watches(&kcorev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.eventingAuthsForApplicationTemplate),
+			builder.WithPredicates(predicate.NewPredicateFuncs(isIasApplicationTemplate))).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.options.MaxConcurrentReconciles}).
+		Complete(tracing.NewReconciler("EventingAuth", r))
 }
 
 type ManagedReconciler interface {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/google/uuid"
 	onsigomegatypes "github.com/onsi/gomega/types"
@@ -81,6 +82,108 @@ var _ = Describe("EventingAuth Controller happy tests", Serial, Ordered, func()
 			secret := verifySecretExistsOnTargetCluster()
 			deleteSecretOnTargetCluster(secret)
 		})
+		It("should skip reconciliation while paused and resume when annotation is removed", func() {
+			// given
+			eventingAuth = createEventingAuthWithAnnotations(crName, map[string]string{eamapiv1alpha1.AnnotationPaused: "true"})
+
+			// then
+			verifyEventingAuthPausedCondition(eventingAuth, kmetav1.ConditionTrue, eamapiv1alpha1.ConditionReasonPaused)
+			By("Verifying that no IAS application secret is created while paused")
+			Consistently(func(g Gomega) {
+				err := targetClusterK8sClient.Get(context.TODO(), appSecretObjectKey, &kcorev1.Secret{})
+				g.Expect(kapierrors.IsNotFound(err)).To(BeTrue())
+			}, 5*time.Second).Should(Succeed())
+
+			// when
+			setEventingAuthAnnotation(eventingAuth, eamapiv1alpha1.AnnotationPaused, "false")
+
+			// then
+			verifyEventingAuthStatusReady(eventingAuth)
+			verifyEventingAuthPausedCondition(eventingAuth, kmetav1.ConditionFalse, eamapiv1alpha1.ConditionReasonResumed)
+			verifySecretExistsOnTargetCluster()
+		})
+		It("should record handled reconcile and credential regeneration requests", func() {
+			// given
+			eventingAuth = createEventingAuth(crName)
+			verifyEventingAuthStatusReady(eventingAuth)
+			verifySecretExistsOnTargetCluster()
+			createdSecret := kcorev1.Secret{}
+			Expect(targetClusterK8sClient.Get(context.TODO(), appSecretObjectKey, &createdSecret)).Should(Succeed())
+
+			// when
+			setEventingAuthAnnotation(eventingAuth, eamapiv1alpha1.AnnotationReconcileRequestedAt, "2024-01-01T00:00:00Z")
+			setEventingAuthAnnotation(eventingAuth, eamapiv1alpha1.AnnotationRegenerateCredentials, "2024-01-01T00:00:00Z")
+
+			// then
+			By("Verifying that the requests are recorded as handled")
+			Eventually(func(g Gomega) {
+				e := eamapiv1alpha1.EventingAuth{}
+				g.Expect(k8sClient.Get(context.TODO(), kpkgclient.ObjectKeyFromObject(eventingAuth), &e)).Should(Succeed())
+				g.Expect(e.Status.LastHandledRequests).NotTo(BeNil())
+				g.Expect(e.Status.LastHandledRequests.ReconcileRequestedAt).To(Equal("2024-01-01T00:00:00Z"))
+				g.Expect(e.Status.LastHandledRequests.RegenerateCredentials).To(Equal("2024-01-01T00:00:00Z"))
+			}, defaultTimeout).Should(Succeed())
+			verifyEventingAuthStatusReady(eventingAuth)
+			verifySecretExistsOnTargetCluster()
+
+			By("Verifying that the secret on the target cluster contains the regenerated credentials")
+			Eventually(func(g Gomega) {
+				s := kcorev1.Secret{}
+				g.Expect(targetClusterK8sClient.Get(context.TODO(), appSecretObjectKey, &s)).Should(Succeed())
+				g.Expect(s.Data["client_id"]).NotTo(Equal(createdSecret.Data["client_id"]))
+				g.Expect(s.Data["client_secret"]).NotTo(Equal(createdSecret.Data["client_secret"]))
+			}, defaultTimeout).Should(Succeed())
+		})
+		It("should recreate the credentials when the credential type changes", func() {
+			// given
+			eventingAuth = createEventingAuth(crName)
+			verifyEventingAuthStatusReady(eventingAuth)
+			verifySecretExistsOnTargetCluster()
+
+			// when
+			By("Changing the credential type to Certificate")
+			Eventually(func(g Gomega) {
+				e := eamapiv1alpha1.EventingAuth{}
+				g.Expect(k8sClient.Get(context.TODO(), kpkgclient.ObjectKeyFromObject(eventingAuth), &e)).Should(Succeed())
+				e.Spec.CredentialType = eamapiv1alpha1.CredentialTypeCertificate
+				g.Expect(k8sClient.Update(context.TODO(), &e)).Should(Succeed())
+			}, defaultTimeout).Should(Succeed())
+
+			// then
+			By("Verifying that the secret on the target cluster contains a client certificate instead of a client secret")
+			Eventually(func(g Gomega) {
+				s := kcorev1.Secret{}
+				g.Expect(targetClusterK8sClient.Get(context.TODO(), appSecretObjectKey, &s)).Should(Succeed())
+				g.Expect(s.Data).To(HaveKey(kcorev1.TLSCertKey))
+				g.Expect(s.Data).To(HaveKey(kcorev1.TLSPrivateKeyKey))
+				g.Expect(s.Data).NotTo(HaveKey("client_secret"))
+			}, defaultTimeout).Should(Succeed())
+			verifyEventingAuthStatusReady(eventingAuth)
+		})
+		It("should keep secret on target cluster when deletion policy is Orphan", func() {
+			// given
+			eventingAuth = createEventingAuthWithDeletionPolicy(crName, eamapiv1alpha1.DeletionPolicyOrphan)
+			verifyEventingAuthStatusReady(eventingAuth)
+
+			// when
+			deleteEventingAuthAndVerify(eventingAuth)
+
+			// then
+			verifySecretExistsOnTargetCluster()
+			deleteApplicationSecretOnTargetCluster()
+		})
+		It("should keep secret on target cluster when deletion policy is OrphanSecretOnly", func() {
+			// given
+			eventingAuth = createEventingAuthWithDeletionPolicy(crName, eamapiv1alpha1.DeletionPolicyOrphanSecretOnly)
+			verifyEventingAuthStatusReady(eventingAuth)
+
+			// when
+			deleteEventingAuthAndVerify(eventingAuth)
+
+			// then
+			verifySecretExistsOnTargetCluster()
+			deleteApplicationSecretOnTargetCluster()
+		})
 		It("should update CR status when application secret already exists", func() {
 			// given
 			// create application secret before creating EventingAuth CR.
@@ -117,7 +220,6 @@ var _ = Describe("EventingAuth Controller unhappy tests", Serial, Ordered, func(
 		deleteApplicationSecretOnTargetCluster()
 		deleteKubeconfigSecret(crName)
 		// set SKR client to original
-		revertReadCredentialsStub()
 		revertSkrNewClientStub()
 		revertIasNewClientStub()
 	})
@@ -128,6 +230,21 @@ var _ = Describe("EventingAuth Controller unhappy tests", Serial, Ordered, func(
 		verifyEventingAuthStatusNotReadyAppCreationFailed(eventingAuth)
 	})
 
+	It("should have failure reason IASUnauthorized when IAS rejects the credentials", func() {
+		stubUnauthorizedIasAppCreation()
+		eventingAuth = createEventingAuth(crName)
+		verifyEventingAuthStatusNotReadyWithReason(eventingAuth, eamapiv1alpha1.ConditionApplicationReady,
+			eamapiv1alpha1.ConditionReasonIASUnauthorized, errIASApplicationUnauthorized.Error())
+	})
+
+	It("should mask client secret of the error in the condition message", func() {
+		stubIasAppCreationFailingWithSecret()
+		eventingAuth = createEventingAuth(crName)
+		verifyEventingAuthStatusNotReadyWithReason(eventingAuth, eamapiv1alpha1.ConditionApplicationReady,
+			eamapiv1alpha1.ConditionReasonApplicationCreationFailed,
+			`stubbed IAS application creation error: {"client_secret":"[REDACTED]"}`)
+	})
+
 	It("should have CR status NotReady when secret creation on target cluster fails", func() {
 		stubSuccessfulIasAppCreation()
 		stubFailedSkrSecretCreation()
@@ -144,6 +261,49 @@ var _ = Describe("EventingAuth Controller unhappy tests", Serial, Ordered, func(
 		verifyEventingAuthStatusReady(eventingAuth)
 	})
 
+	It("should have DuplicateApplications condition when multiple IAS applications with the same name exist", func() {
+		stubDuplicateIasApps()
+		eventingAuth = createEventingAuth(crName)
+		By(fmt.Sprintf("Verifying that EventingAuth %s reports the duplicate applications", crName))
+		Eventually(func(g Gomega) {
+			e := eamapiv1alpha1.EventingAuth{}
+			g.Expect(k8sClient.Get(context.TODO(), kpkgclient.ObjectKeyFromObject(eventingAuth), &e)).Should(Succeed())
+			g.Expect(e.Status.State).To(Equal(eamapiv1alpha1.StateNotReady))
+			g.Expect(e.Status.Conditions).To(ContainElements(
+				conditionMatcher(
+					string(eamapiv1alpha1.ConditionDuplicateApplications),
+					kmetav1.ConditionTrue,
+					eamapiv1alpha1.ConditionReasonDuplicatesFound,
+					"Found multiple IAS applications with the same name: duplicate-app-id-1, duplicate-app-id-2."),
+			))
+		}, defaultTimeout).Should(Succeed())
+	})
+
+	It("should remove finalizer when IAS app deletion fails and force-delete annotation is set", func() {
+		// given
+		stubFailedIasAppDeletion()
+		stubSuccessfulSkrSecretCreation()
+		eventingAuth = createEventingAuth(crName)
+		verifyEventingAuthStatusReady(eventingAuth)
+
+		// when
+		By(fmt.Sprintf("Deleting EventingAuth %s", eventingAuth.Name))
+		Expect(k8sClient.Delete(context.TODO(), eventingAuth)).Should(Succeed())
+
+		// then
+		By("Verifying that EventingAuth is kept as long as the IAS application can't be deleted")
+		Consistently(func(g Gomega) {
+			g.Expect(k8sClient.Get(context.TODO(), kpkgclient.ObjectKeyFromObject(eventingAuth), &eamapiv1alpha1.EventingAuth{})).Should(Succeed())
+		}, 5*time.Second).Should(Succeed())
+
+		// when
+		setEventingAuthAnnotation(eventingAuth, eamapiv1alpha1.AnnotationForceDelete, "true")
+
+		// then
+		deleteEventingAuthAndVerify(eventingAuth)
+		verifySecretDoesNotExistOnTargetCluster()
+	})
+
 	It("should retry and create secret when first attempt of secret creation failed", func() {
 		stubSuccessfulIasAppCreation()
 		stubFailedSkrSecretCreation()
@@ -173,6 +333,66 @@ func createEventingAuth(name string) *eamapiv1alpha1.EventingAuth {
 	return &e
 }
 
+func createEventingAuthWithAnnotations(name string, annotations map[string]string) *eamapiv1alpha1.EventingAuth {
+	e := eamapiv1alpha1.EventingAuth{
+		ObjectMeta: kmetav1.ObjectMeta{
+			Name:        name,
+			Namespace:   skr.KcpNamespace,
+			Annotations: annotations,
+		},
+	}
+
+	By("Creating EventingAuth CR with annotations")
+	Expect(k8sClient.Create(context.TODO(), &e)).Should(Succeed())
+
+	return &e
+}
+
+func setEventingAuthAnnotation(cr *eamapiv1alpha1.EventingAuth, key, value string) {
+	By(fmt.Sprintf("Setting annotation %s=%s on EventingAuth %s", key, value, cr.Name))
+	Eventually(func(g Gomega) {
+		e := eamapiv1alpha1.EventingAuth{}
+		g.Expect(k8sClient.Get(context.TODO(), kpkgclient.ObjectKeyFromObject(cr), &e)).Should(Succeed())
+		if e.Annotations == nil {
+			e.Annotations = map[string]string{}
+		}
+		e.Annotations[key] = value
+		g.Expect(k8sClient.Update(context.TODO(), &e)).Should(Succeed())
+	}, defaultTimeout).Should(Succeed())
+}
+
+func verifyEventingAuthPausedCondition(cr *eamapiv1alpha1.EventingAuth, status kmetav1.ConditionStatus, reason string) {
+	By(fmt.Sprintf("Verifying that EventingAuth %s has Paused condition %s", cr.Name, status))
+	Eventually(func(g Gomega) {
+		e := eamapiv1alpha1.EventingAuth{}
+		g.Expect(k8sClient.Get(context.TODO(), kpkgclient.ObjectKeyFromObject(cr), &e)).Should(Succeed())
+		g.Expect(e.Status.Conditions).To(ContainElement(HaveField("Type", string(eamapiv1alpha1.ConditionPaused))))
+		for _, c := range e.Status.Conditions {
+			if c.Type == string(eamapiv1alpha1.ConditionPaused) {
+				g.Expect(c.Status).To(Equal(status))
+				g.Expect(c.Reason).To(Equal(reason))
+			}
+		}
+	}, defaultTimeout).Should(Succeed())
+}
+
+func createEventingAuthWithDeletionPolicy(name string, policy eamapiv1alpha1.DeletionPolicy) *eamapiv1alpha1.EventingAuth {
+	e := eamapiv1alpha1.EventingAuth{
+		ObjectMeta: kmetav1.ObjectMeta{
+			Name:      name,
+			Namespace: skr.KcpNamespace,
+		},
+		Spec: eamapiv1alpha1.EventingAuthSpec{
+			DeletionPolicy: policy,
+		},
+	}
+
+	By(fmt.Sprintf("Creating EventingAuth CR with deletion policy %s", policy))
+	Expect(k8sClient.Create(context.TODO(), &e)).Should(Succeed())
+
+	return &e
+}
+
 func createEventingAuthWithWrongOwnerRef(name string) *eamapiv1alpha1.EventingAuth {
 	e := eamapiv1alpha1.EventingAuth{
 		ObjectMeta: kmetav1.ObjectMeta{
@@ -246,7 +466,14 @@ func verifyEventingAuthStatusReady(cr *eamapiv1alpha1.EventingAuth) {
 				kmetav1.ConditionTrue,
 				eamapiv1alpha1.ConditionReasonSecretCreated,
 				eamapiv1alpha1.ConditionMessageSecretCreated),
+			conditionMatcher(
+				string(eamapiv1alpha1.ConditionReady),
+				kmetav1.ConditionTrue,
+				eamapiv1alpha1.ConditionReasonReady,
+				eamapiv1alpha1.ConditionMessageReady),
 		))
+		g.Expect(e.Status.ObservedGeneration).To(Equal(e.Generation))
+		g.Expect(e.Status.LastSuccessfulSyncTime).NotTo(BeNil())
 	}, defaultTimeout).Should(Succeed())
 }
 
@@ -268,6 +495,20 @@ func verifyEventingAuthStatusNotReadyAppCreationFailed(cr *eamapiv1alpha1.Eventi
 	}, defaultTimeout).Should(Succeed())
 }
 
+func verifyEventingAuthStatusNotReadyWithReason(cr *eamapiv1alpha1.EventingAuth, conditionType eamapiv1alpha1.ConditionType, reason, message string) {
+	By(fmt.Sprintf("Verifying that EventingAuth %s has status %s with reason %s", cr.Name, eamapiv1alpha1.StateNotReady, reason))
+	Eventually(func(g Gomega) {
+		e := eamapiv1alpha1.EventingAuth{}
+		g.Expect(k8sClient.Get(context.TODO(), kpkgclient.ObjectKeyFromObject(cr), &e)).Should(Succeed())
+		g.Expect(e.Status.State).To(Equal(eamapiv1alpha1.StateNotReady))
+
+		g.Expect(e.Status.Conditions).To(ContainElements(
+			conditionMatcher(string(conditionType), kmetav1.ConditionFalse, reason, message),
+			conditionMatcher(string(eamapiv1alpha1.ConditionReady), kmetav1.ConditionFalse, reason, message),
+		))
+	}, defaultTimeout).Should(Succeed())
+}
+
 func verifyEventingAuthStatusNotReadySecretCreationFailed(cr *eamapiv1alpha1.EventingAuth) {
 	By(fmt.Sprintf("Verifying that EventingAuth %s has status %s", cr.Name, eamapiv1alpha1.StateNotReady))
 	Eventually(func(g Gomega) {
@@ -0,0 +1,45 @@
+package controllers
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	kcorev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	eamapiv1alpha1 "github.com/kyma-project/eventing-auth-manager/api/v1alpha1"
+	eammetrics "github.com/kyma-project/eventing-auth-manager/internal/metrics"
+)
+
+// Reasons for removing the finalizer of an EventingAuth CR without a successful clean-up.
+const (
+	forceDeletionReasonAnnotation       = "force-delete"
+	forceDeletionReasonDeadlineExceeded = "deadline-exceeded"
+)
+
+// forceDeletionReason returns the reason why the finalizer of the deleted CR must be removed even if the clean-up fails, or an
+// empty string if the deletion is not forced. The deletion is forced by the force-delete annotation or if the deletion deadline is
+// exceeded, so that the CR and its Kyma CR don't hang in deletion if IAS is permanently unreachable.
+func (r *eventingAuthReconciler) forceDeletionReason(cr *eamapiv1alpha1.EventingAuth) string {
+	if cr.DeletionTimestamp.IsZero() {
+		return ""
+	}
+	if cr.Annotations[eamapiv1alpha1.AnnotationForceDelete] == "true" {
+		return forceDeletionReasonAnnotation
+	}
+	if r.options.DeletionDeadline > 0 && time.Since(cr.DeletionTimestamp.Time) >= r.options.DeletionDeadline {
+		return forceDeletionReasonDeadlineExceeded
+	}
+	return ""
+}
+
+// recordLeakedResources records the resources left behind by the forced deletion in an event of the CR and in the metrics.
+func (r *eventingAuthReconciler) recordLeakedResources(ctx context.Context, cr *eamapiv1alpha1.EventingAuth, reason string, leaked []string) {
+	for _, resource := range leaked {
+		eammetrics.RecordLeakedResource(resource, reason)
+	}
+	log.FromContext(ctx).Info("Removing finalizer without successful clean-up", "reason", reason, "leakedResources", leaked)
+	r.recorder.Eventf(cr, kcorev1.EventTypeWarning, eventReasonResourcesLeaked,
+		"Removed finalizer without successful clean-up (%s), left behind: %s", reason, strings.Join(leaked, ", "))
+}
@@ -0,0 +1,29 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	kapimeta "k8s.io/apimachinery/pkg/api/meta"
+
+	eamapiv1alpha1 "github.com/kyma-project/eventing-auth-manager/api/v1alpha1"
+)
+
+// handlePause records whether the reconciliation of the CR is paused in the Paused condition and returns true if it is paused. The
+// condition is only added once the CR was paused, so that CRs which were never paused don't carry it.
+func (r *eventingAuthReconciler) handlePause(ctx context.Context, logger logr.Logger, cr *eamapiv1alpha1.EventingAuth) (bool, error) {
+	paused := eamapiv1alpha1.IsPaused(cr)
+	if !paused && kapimeta.FindStatusCondition(cr.Status.Conditions, string(eamapiv1alpha1.ConditionPaused)) == nil {
+		return false, nil
+	}
+
+	if paused {
+		logger.Info("Skipping reconciliation of paused EventingAuth", "annotation", eamapiv1alpha1.AnnotationPaused)
+		if !cr.DeletionTimestamp.IsZero() {
+			cr.Status.Conditions = eamapiv1alpha1.MakeDeletionBlockedCondition(cr, eamapiv1alpha1.ConditionReasonPaused,
+				eamapiv1alpha1.ConditionMessageDeletionPaused)
+		}
+	}
+	cr.Status.Conditions = eamapiv1alpha1.MakePausedCondition(cr, paused)
+	return paused, r.syncEventingAuthStatus(ctx, cr)
+}
@@ -0,0 +1,37 @@
+package controllers
+
+import (
+	"github.com/pkg/errors"
+
+	eamapiv1alpha1 "github.com/kyma-project/eventing-auth-manager/api/v1alpha1"
+	eamias "github.com/kyma-project/eventing-auth-manager/internal/ias"
+	"github.com/kyma-project/eventing-auth-manager/internal/skr"
+)
+
+// failureReason maps the error of a failed reconciliation step onto the reason of the failed condition, so that alerts can be routed
+// by cause. An empty reason keeps the default failure reason of the condition.
+func failureReason(err error) string {
+	var duplicatesErr *eamias.DuplicateApplicationsError
+	switch {
+	case errors.Is(err, eamias.ErrCredentialsInvalid):
+		return eamapiv1alpha1.ConditionReasonIASCredentialsInvalid
+	case errors.Is(err, errIasClientNotInitialized):
+		return eamapiv1alpha1.ConditionReasonIASCredentialsMissing
+	case errors.Is(err, eamias.ErrUnauthorized):
+		return eamapiv1alpha1.ConditionReasonIASUnauthorized
+	case errors.Is(err, eamias.ErrRateLimited):
+		return eamapiv1alpha1.ConditionReasonIASRateLimited
+	case errors.Is(err, eamias.ErrOIDCDiscoveryFailed):
+		return eamapiv1alpha1.ConditionReasonOIDCDiscoveryFailed
+	case errors.As(err, &duplicatesErr):
+		return eamapiv1alpha1.ConditionReasonDuplicatesFound
+	case errors.Is(err, skr.ErrKubeconfigMissing):
+		return eamapiv1alpha1.ConditionReasonKubeconfigMissing
+	case errors.Is(err, skr.ErrUnreachable):
+		return eamapiv1alpha1.ConditionReasonSKRUnreachable
+	case errors.Is(err, skr.ErrSecretConflict):
+		return eamapiv1alpha1.ConditionReasonSecretConflict
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,117 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	kcorev1 "k8s.io/api/core/v1"
+
+	eamapiv1alpha1 "github.com/kyma-project/eventing-auth-manager/api/v1alpha1"
+	"github.com/kyma-project/eventing-auth-manager/internal/audit"
+	eamias "github.com/kyma-project/eventing-auth-manager/internal/ias"
+	"github.com/kyma-project/eventing-auth-manager/internal/skr"
+)
+
+// pendingCredentialsRegeneration returns true if the regenerate-credentials annotation requests new credentials that were not
+// issued yet.
+func pendingCredentialsRegeneration(cr *eamapiv1alpha1.EventingAuth) bool {
+	request := cr.Annotations[eamapiv1alpha1.AnnotationRegenerateCredentials]
+	if request == "" {
+		return false
+	}
+	return cr.Status.LastHandledRequests == nil || cr.Status.LastHandledRequests.RegenerateCredentials != request
+}
+
+// recordHandledRequests records the values of the request annotations as handled in the status of the CR, so that each request is
+// handled only once. The regeneration of the credentials is only recorded if new credentials were issued.
+func recordHandledRequests(cr *eamapiv1alpha1.EventingAuth, credentialsIssued bool) {
+	handled := eamapiv1alpha1.HandledRequests{}
+	if cr.Status.LastHandledRequests != nil {
+		handled = *cr.Status.LastHandledRequests
+	}
+	if request, exists := cr.Annotations[eamapiv1alpha1.AnnotationReconcileRequestedAt]; exists {
+		handled.ReconcileRequestedAt = request
+	}
+	if request, exists := cr.Annotations[eamapiv1alpha1.AnnotationRegenerateCredentials]; exists && credentialsIssued {
+		handled.RegenerateCredentials = request
+	}
+	if handled != (eamapiv1alpha1.HandledRequests{}) {
+		cr.Status.LastHandledRequests = &handled
+	}
+}
+
+// regenerateCredentials issues new credentials for the IAS application, replaces the credentials in the secret on the managed
+// runtime, and then revokes the previous credentials, also those of a previous credential type. The application is kept, so that the
+// managed runtime can use its credentials until the new ones are delivered. The renewal reason is empty if the credentials are
+// regenerated on request of the regenerate-credentials annotation.
+func (r *eventingAuthReconciler) regenerateCredentials(ctx context.Context, logger logr.Logger, iasClient eamias.Client, skrClient skr.Client,
+	cr *eamapiv1alpha1.EventingAuth, renewalReason eamias.RenewalReason,
+) error {
+	appConfig, err := r.desiredApplicationConfig(ctx, *cr)
+	if err != nil {
+		if err := r.updateEventingAuthStatus(ctx, cr, eamapiv1alpha1.ConditionApplicationReady, err); err != nil {
+			return err
+		}
+		return err
+	}
+
+	logger.Info("Regenerating credentials of application in IAS", "renewalReason", renewalReason, "credentialType", appConfig.CredentialType)
+	iasApplication, err := iasClient.RegenerateCredentials(ctx, cr.Name, appConfig)
+	if err != nil {
+		logger.Error(err, "Failed to regenerate credentials of application in IAS")
+		r.recordDuplicateApplications(ctx, logger, iasClient, cr, err)
+		if err := r.updateEventingAuthStatus(ctx, cr, eamapiv1alpha1.ConditionApplicationReady, err); err != nil {
+			return err
+		}
+		return err
+	}
+	// the cached application holds the previous credentials
+	r.existingIasApplications.delete(cr.Name)
+	cr.Status.Application = &eamapiv1alpha1.IASApplication{
+		Name: cr.Name,
+		UUID: iasApplication.GetID(),
+	}
+	if _, err := eamapiv1alpha1.UpdateConditionAndState(cr, eamapiv1alpha1.ConditionApplicationReady, nil); err != nil {
+		return err
+	}
+
+	logger.Info("Updating application secret on SKR")
+	appSecret, err := skrClient.UpdateSecret(ctx, iasApplication)
+	r.audit(audit.OperationUpdateSecret, cr.Name, iasApplication.GetID(), err)
+	if err != nil {
+		logger.Error(err, "Failed to update application secret on SKR")
+		if err := r.updateEventingAuthStatus(ctx, cr, eamapiv1alpha1.ConditionSecretReady, err); err != nil {
+			return err
+		}
+		return err
+	}
+
+	cr.Status.AuthSecret = &eamapiv1alpha1.AuthSecret{
+		ClusterID:      cr.Name,
+		NamespacedName: fmt.Sprintf("%s/%s", appSecret.Namespace, appSecret.Name),
+	}
+
+	// The previous credentials are only revoked after the new credentials were delivered, so that the managed runtime never holds
+	// revoked credentials. If the revocation fails, the request isn't recorded as handled, so that it is retried.
+	logger.Info("Revoking previous credentials of application in IAS")
+	if err := iasClient.RevokeCredentials(ctx, cr.Name, iasApplication); err != nil {
+		logger.Error(err, "Failed to revoke previous credentials of application in IAS")
+		return err
+	}
+
+	recordHandledRequests(cr, true)
+	recordSuccessfulSync(cr)
+	if err := r.updateEventingAuthStatus(ctx, cr, eamapiv1alpha1.ConditionSecretReady, nil); err != nil {
+		return err
+	}
+	if renewalReason != "" {
+		r.recorder.Eventf(cr, kcorev1.EventTypeNormal, eventReasonCredentialsRenewed,
+			"Renewed credentials of IAS application and updated secret on the managed runtime: %s", renewalReason)
+	} else {
+		r.recorder.Event(cr, kcorev1.EventTypeNormal, eventReasonCredentialsRegenerated,
+			"Regenerated credentials of IAS application and updated secret on the managed runtime")
+	}
+	logger.Info("Successfully regenerated credentials")
+	return nil
+}
@@ -0,0 +1,61 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// iasReadinessTimeout limits the duration of the requests to IAS of a readiness check.
+const iasReadinessTimeout = 5 * time.Second
+
+// IasReadinessChecker verifies that the IAS credentials are loaded, that the Applications API of IAS accepts them, and that the OIDC
+// configuration of the tenant can be fetched. The result is cached for the TTL, so that frequent probes don't put load on IAS. The
+// cached result is discarded when the IAS client is replaced.
+type IasReadinessChecker struct {
+	iasCredentials *IasCredentialsReconciler
+	ttl            time.Duration
+	now            func() time.Time
+
+	mu                sync.Mutex
+	checked           bool
+	checkedGeneration uint64
+	checkedAt         time.Time
+	result            error
+}
+
+func NewIasReadinessChecker(iasCredentials *IasCredentialsReconciler, ttl time.Duration) *IasReadinessChecker {
+	return &IasReadinessChecker{
+		iasCredentials: iasCredentials,
+		ttl:            ttl,
+		now:            time.Now,
+	}
+}
+
+// Check fails if IAS can't be reached with the current IAS credentials. It can be registered as a readiness check of the manager.
+func (c *IasReadinessChecker) Check(req *http.Request) error {
+	iasClient, generation, err := c.iasCredentials.getIasClientGeneration()
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.checked && c.checkedGeneration == generation && c.now().Sub(c.checkedAt) < c.ttl {
+		return c.result
+	}
+
+	ctx := context.Background()
+	if req != nil {
+		ctx = req.Context()
+	}
+	ctx, cancel := context.WithTimeout(ctx, iasReadinessTimeout)
+	defer cancel()
+
+	c.result = iasClient.Ping(ctx)
+	c.checked = true
+	c.checkedGeneration = generation
+	c.checkedAt = c.now()
+	return c.result
+}
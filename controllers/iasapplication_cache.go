@@ -0,0 +1,39 @@
+package controllers
+
+import (
+	"sync"
+
+	eamias "github.com/kyma-project/eventing-auth-manager/internal/ias"
+)
+
+// iasApplicationCache stores the IAS applications that were created, but whose secret is not yet created on the managed runtime.
+// It is safe for concurrent use, since the EventingAuth CRs are reconciled by multiple workers.
+type iasApplicationCache struct {
+	mu           sync.Mutex
+	applications map[string]eamias.Application
+}
+
+func newIasApplicationCache() *iasApplicationCache {
+	return &iasApplicationCache{
+		applications: map[string]eamias.Application{},
+	}
+}
+
+func (c *iasApplicationCache) get(name string) (eamias.Application, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	app, exists := c.applications[name]
+	return app, exists
+}
+
+func (c *iasApplicationCache) set(name string, app eamias.Application) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.applications[name] = app
+}
+
+func (c *iasApplicationCache) delete(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.applications, name)
+}
@@ -0,0 +1,136 @@
+package controllers
+
+import (
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+	kpkgclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	eamapiv1alpha1 "github.com/kyma-project/eventing-auth-manager/api/v1alpha1"
+	eamias "github.com/kyma-project/eventing-auth-manager/internal/ias"
+)
+
+const (
+	iasApplicationTemplateNamespace        string = "IAS_APPLICATION_TEMPLATE_NAMESPACE"
+	iasApplicationTemplateName             string = "IAS_APPLICATION_TEMPLATE_NAME"
+	defaultIasApplicationTemplateNamespace string = "kcp-system"
+	DefaultIasApplicationTemplateName      string = "eventing-auth-ias-application-template"
+)
+
+// desiredApplicationConfig returns the configuration of the IAS application for the given EventingAuth CR. The application template
+// of the operator is read from a ConfigMap and the settings of the template in the spec of the CR take precedence.
+func (r *eventingAuthReconciler) desiredApplicationConfig(ctx context.Context, cr eamapiv1alpha1.EventingAuth) (eamias.ApplicationConfig, error) {
+	namespace, name := getIasApplicationTemplateNamespaceAndNameConfigs()
+	template, err := eamias.ReadApplicationTemplate(ctx, namespace, name, r.Client)
+	if err != nil {
+		return eamias.ApplicationConfig{}, errors.Wrap(err, "failed to read IAS application template")
+	}
+	if cr.Spec.ApplicationTemplate != nil {
+		template = template.Merge(toIasApplicationTemplate(*cr.Spec.ApplicationTemplate))
+	}
+
+	return eamias.ApplicationConfig{
+		GlobalAccountID: r.options.GlobalAccountID,
+		CredentialType:  iasCredentialType(cr),
+		Template:        template,
+		Owner:           r.applicationOwner(cr),
+	}, nil
+}
+
+// applicationOwner returns the ownership of the IAS application of the given EventingAuth CR. The application ID recorded in the
+// status allows to adopt applications that were created before the ownership was recorded in the applications.
+func (r *eventingAuthReconciler) applicationOwner(cr eamapiv1alpha1.EventingAuth) eamias.Ownership {
+	owner := eamias.Ownership{
+		RuntimeID:    cr.Name,
+		KcpNamespace: cr.Namespace,
+		InstanceID:   r.options.InstanceID,
+	}
+	if cr.Status.Application != nil {
+		owner.ApplicationID = cr.Status.Application.UUID
+	}
+	return owner
+}
+
+// iasCredentialType maps the credential type of the EventingAuth spec to the credential type of the IAS application.
+func iasCredentialType(cr eamapiv1alpha1.EventingAuth) eamias.CredentialType {
+	if cr.Spec.CredentialType == eamapiv1alpha1.CredentialTypeCertificate {
+		return eamias.CredentialTypeCertificate
+	}
+	return eamias.CredentialTypeSecret
+}
+
+func toIasApplicationTemplate(t eamapiv1alpha1.IASApplicationTemplate) eamias.ApplicationTemplate {
+	template := eamias.ApplicationTemplate{
+		Description:          t.Description,
+		JwtValidity:          t.JwtValidity,
+		RestrictedGrantTypes: t.RestrictedGrantTypes,
+		ParentApplicationID:  t.ParentApplicationID,
+	}
+	if t.ProvidedAPIs != nil {
+		template.ProvidedAPIs = make([]eamias.ProvidedAPI, 0, len(t.ProvidedAPIs))
+		for _, p := range t.ProvidedAPIs {
+			template.ProvidedAPIs = append(template.ProvidedAPIs, eamias.ProvidedAPI{Name: p.Name, Description: p.Description})
+		}
+	}
+	if t.ConsumedAPIs != nil {
+		template.ConsumedAPIs = make([]eamias.ConsumedAPI, 0, len(t.ConsumedAPIs))
+		for _, c := range t.ConsumedAPIs {
+			template.ConsumedAPIs = append(template.ConsumedAPIs, eamias.ConsumedAPI{Name: c.Name, APIName: c.APIName, AppID: c.AppID, ClientID: c.ClientID})
+		}
+	}
+	if t.AssertionAttributes != nil {
+		template.AssertionAttributes = make([]eamias.AssertionAttribute, 0, len(t.AssertionAttributes))
+		for _, a := range t.AssertionAttributes {
+			template.AssertionAttributes = append(template.AssertionAttributes, eamias.AssertionAttribute{
+				AssertionAttributeName: a.AssertionAttributeName,
+				UserAttributeName:      a.UserAttributeName,
+			})
+		}
+	}
+	if t.AdvancedAssertionAttributes != nil {
+		template.AdvancedAssertionAttributes = make([]eamias.AdvancedAssertionAttribute, 0, len(t.AdvancedAssertionAttributes))
+		for _, a := range t.AdvancedAssertionAttributes {
+			template.AdvancedAssertionAttributes = append(template.AdvancedAssertionAttributes, eamias.AdvancedAssertionAttribute{
+				AttributeName:  a.AttributeName,
+				AttributeValue: a.AttributeValue,
+			})
+		}
+	}
+	return template
+}
+
+// isIasApplicationTemplate returns true if the object is the ConfigMap with the application template of the operator.
+func isIasApplicationTemplate(o kpkgclient.Object) bool {
+	namespace, name := getIasApplicationTemplateNamespaceAndNameConfigs()
+	return o.GetNamespace() == namespace && o.GetName() == name
+}
+
+// eventingAuthsForApplicationTemplate maps a change of the application template to the requests of all EventingAuth CRs, so that the
+// changed settings are patched into their IAS applications.
+func (r *eventingAuthReconciler) eventingAuthsForApplicationTemplate(ctx context.Context, _ kpkgclient.Object) []reconcile.Request {
+	eventingAuths := &eamapiv1alpha1.EventingAuthList{}
+	if err := r.Client.List(ctx, eventingAuths); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list EventingAuth resources for the changed IAS application template")
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(eventingAuths.Items))
+	for _, eventingAuth := range eventingAuths.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: kpkgclient.ObjectKeyFromObject(&eventingAuth)})
+	}
+	return requests
+}
+
+func getIasApplicationTemplateNamespaceAndNameConfigs() (string, string) {
+	namespace := os.Getenv(iasApplicationTemplateNamespace)
+	if len(namespace) == 0 {
+		namespace = defaultIasApplicationTemplateNamespace
+	}
+	name := os.Getenv(iasApplicationTemplateName)
+	if len(name) == 0 {
+		name = DefaultIasApplicationTemplateName
+	}
+	return namespace, name
+}
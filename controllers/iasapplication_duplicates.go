@@ -0,0 +1,51 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+
+	eamapiv1alpha1 "github.com/kyma-project/eventing-auth-manager/api/v1alpha1"
+	eamias "github.com/kyma-project/eventing-auth-manager/internal/ias"
+)
+
+// duplicatePolicy returns the duplicate application policy of the annotation of the EventingAuth CR. If the annotation is not set or
+// invalid, the duplicate application policy of the operator is returned.
+func (r *eventingAuthReconciler) duplicatePolicy(logger logr.Logger, cr *eamapiv1alpha1.EventingAuth) eamias.DuplicatePolicy {
+	value, exists := cr.Annotations[eamapiv1alpha1.AnnotationDuplicateApplicationPolicy]
+	if !exists {
+		return r.options.DuplicatePolicy
+	}
+	policy, err := eamias.ParseDuplicatePolicy(value)
+	if err != nil {
+		logger.Error(err, "Ignoring invalid duplicate application policy annotation", "annotation", eamapiv1alpha1.AnnotationDuplicateApplicationPolicy)
+		return r.options.DuplicatePolicy
+	}
+	return policy
+}
+
+// recordDuplicateApplications resolves the duplicate IAS applications reported by the given error according to the duplicate
+// application policy and records them in the DuplicateApplications condition of the CR. It returns true if the condition was
+// updated, the status of the CR is not synced.
+func (r *eventingAuthReconciler) recordDuplicateApplications(ctx context.Context, logger logr.Logger, iasClient eamias.Client, cr *eamapiv1alpha1.EventingAuth, err error) bool {
+	var duplicatesErr *eamias.DuplicateApplicationsError
+	if !errors.As(err, &duplicatesErr) {
+		return false
+	}
+
+	policy := r.duplicatePolicy(logger, cr)
+	keptID, resolveErr := iasClient.ResolveDuplicateApplications(ctx, cr.Name, policy, r.applicationOwner(*cr))
+	if resolveErr != nil {
+		logger.Error(resolveErr, "Failed to resolve duplicate IAS applications", "ids", duplicatesErr.IDs, "policy", policy)
+		keptID = ""
+	} else if keptID == "" {
+		// the duplicates were removed in the meantime
+		return false
+	} else {
+		logger.Info("Resolved duplicate IAS applications", "ids", duplicatesErr.IDs, "keptId", keptID, "policy", policy)
+	}
+
+	cr.Status.Conditions = eamapiv1alpha1.MakeDuplicateApplicationsCondition(cr, duplicatesErr.IDs, keptID)
+	return true
+}
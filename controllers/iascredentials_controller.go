@@ -0,0 +1,249 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"sync"
+
+	"github.com/pkg/errors"
+	kcorev1 "k8s.io/api/core/v1"
+	kapierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	kcontrollerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	kpkgclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	eamapiv1alpha1 "github.com/kyma-project/eventing-auth-manager/api/v1alpha1"
+	eamias "github.com/kyma-project/eventing-auth-manager/internal/ias"
+	"github.com/kyma-project/eventing-auth-manager/internal/logging"
+	"github.com/kyma-project/eventing-auth-manager/internal/tracing"
+)
+
+var errIasClientNotInitialized = errors.New("IAS client is not initialized, IAS credentials have not been loaded yet")
+
+// IasCredentialsReconciler watches the Secret with the IAS credentials and rebuilds the IAS client whenever the Secret changes.
+// The current IAS client is shared with the EventingAuth reconciler. The reconciler runs on every replica, not only on the leader, since
+// the readiness checks depend on the IAS client and a replica waiting for the leader lease must become ready during a rollout.
+type IasCredentialsReconciler struct {
+	kpkgclient.Client
+	secretNamespace string
+	secretName      string
+	// clientOptions configure the IAS clients that are created from the credentials.
+	clientOptions eamias.ClientOptions
+	// caBundleSecret is the optional secret with the CA bundle that is trusted by the IAS clients in addition to the system certificates.
+	caBundleSecret types.NamespacedName
+	// caBundle is the CA bundle of the current IAS client, which is rebuilt when the CA bundle changes.
+	caBundle []byte
+
+	mu        sync.RWMutex
+	iasClient eamias.Client
+	// loadErr is the error of the last failed attempt to load the IAS credentials, which is reported instead of the IAS client.
+	loadErr error
+	// generation is increased whenever the IAS client is replaced.
+	generation uint64
+	// eventingAuthEvents is used to trigger the reconciliation of all EventingAuth CRs when the IAS tenant changes.
+	eventingAuthEvents chan event.GenericEvent
+	// elected is closed when the replica becomes the leader. Only the leader runs the EventingAuth controller that receives the events.
+	elected <-chan struct{}
+}
+
+// NewIasCredentialsReconciler creates the reconciler of the IAS credentials secret. If the name of the CA bundle secret is empty, the
+// IAS clients only trust the system certificates.
+func NewIasCredentialsReconciler(c kpkgclient.Client, clientOptions eamias.ClientOptions, caBundleSecret types.NamespacedName) *IasCredentialsReconciler {
+	namespace, name := getIasSecretNamespaceAndNameConfigs()
+	return &IasCredentialsReconciler{
+		Client:             c,
+		secretNamespace:    namespace,
+		secretName:         name,
+		clientOptions:      clientOptions,
+		caBundleSecret:     caBundleSecret,
+		eventingAuthEvents: make(chan event.GenericEvent),
+	}
+}
+
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=watch,list
+// The request is either for the IAS credentials secret or the CA bundle secret, but both secrets are always read to build the IAS client.
+func (r *IasCredentialsReconciler) Reconcile(ctx context.Context, _ kcontrollerruntime.Request) (kcontrollerruntime.Result, error) {
+	logger := logging.FromContext(ctx, logging.ComponentControllers)
+	ctx = log.IntoContext(ctx, logger)
+	logger.Info("Reconciling IAS credentials")
+
+	previousIasClient, _ := r.GetIasClient()
+	previousLoadErr := r.loadError()
+
+	secretDescription, secretName := "IAS credentials", types.NamespacedName{Namespace: r.secretNamespace, Name: r.secretName}
+	newIasCredentials, err := eamias.ReadCredentials(r.secretNamespace, r.secretName, r.Client)
+	var caBundle []byte
+	if err == nil && r.caBundleSecret.Name != "" {
+		secretDescription, secretName = "IAS CA bundle", r.caBundleSecret
+		caBundle, err = eamias.ReadCABundle(ctx, r.caBundleSecret, r.Client)
+	}
+	if err != nil {
+		switch {
+		case kapierrors.IsNotFound(err):
+			logger.Info(secretDescription + " secret not found, resetting IAS client")
+			err = errors.Errorf("%s secret %s not found", secretDescription, secretName)
+		case errors.Is(err, eamias.ErrCredentialsInvalid):
+			logger.Error(err, secretDescription+" secret is invalid, resetting IAS client")
+		default:
+			return kcontrollerruntime.Result{}, err
+		}
+		r.setIasClient(nil, err)
+		// the failure is reported in the status of all EventingAuth CRs, but only once per distinct failure
+		if previousIasClient != nil || previousLoadErr == nil || previousLoadErr.Error() != err.Error() {
+			return kcontrollerruntime.Result{}, r.enqueueAllEventingAuths(ctx)
+		}
+		return kcontrollerruntime.Result{}, nil
+	}
+
+	// keep the current client unless credentials or the CA bundle are changed
+	if previousIasClient != nil && reflect.DeepEqual(previousIasClient.GetCredentials(), newIasCredentials) && bytes.Equal(r.caBundle, caBundle) {
+		return kcontrollerruntime.Result{}, nil
+	}
+
+	clientOptions := r.clientOptions
+	clientOptions.Transport.CABundle = caBundle
+	iasClient, err := eamias.NewClient(newIasCredentials.URL, newIasCredentials.Username, newIasCredentials.Password, clientOptions)
+	if err != nil {
+		return kcontrollerruntime.Result{}, errors.Wrap(err, "failed to create a new IAS client")
+	}
+	r.caBundle = caBundle
+	r.setIasClient(iasClient, nil)
+	logger.Info("IAS client is updated with the new credentials")
+
+	if previousIasClient != nil && previousIasClient.GetCredentials().URL != newIasCredentials.URL {
+		logger.Info("IAS tenant URL changed, triggering reconciliation of all EventingAuth CRs")
+		if err := r.enqueueAllEventingAuths(ctx); err != nil {
+			return kcontrollerruntime.Result{}, err
+		}
+	}
+	if previousIasClient == nil && previousLoadErr != nil {
+		logger.Info("IAS credentials recovered, triggering reconciliation of all EventingAuth CRs")
+		if err := r.enqueueAllEventingAuths(ctx); err != nil {
+			return kcontrollerruntime.Result{}, err
+		}
+	}
+
+	return kcontrollerruntime.Result{}, nil
+}
+
+// GetIasClient returns the IAS client that was built from the latest IAS credentials. If the IAS credentials could not be loaded,
+// the returned error contains the reason.
+func (r *IasCredentialsReconciler) GetIasClient() (eamias.Client, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.currentIasClient()
+}
+
+// getIasClientGeneration returns the current IAS client together with its generation, which changes whenever the client is replaced.
+func (r *IasCredentialsReconciler) getIasClientGeneration() (eamias.Client, uint64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	iasClient, err := r.currentIasClient()
+	return iasClient, r.generation, err
+}
+
+// currentIasClient must be called with the lock held.
+func (r *IasCredentialsReconciler) currentIasClient() (eamias.Client, error) {
+	if r.iasClient == nil {
+		if r.loadErr != nil {
+			return nil, fmt.Errorf("%w: %w", errIasClientNotInitialized, r.loadErr)
+		}
+		return nil, errIasClientNotInitialized
+	}
+	return r.iasClient, nil
+}
+
+func (r *IasCredentialsReconciler) setIasClient(c eamias.Client, loadErr error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.iasClient = c
+	r.loadErr = loadErr
+	r.generation++
+}
+
+func (r *IasCredentialsReconciler) loadError() error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.loadErr
+}
+
+// ReadyzCheck fails as long as no IAS client could be built from the IAS credentials secret, e.g. because the secret is missing or
+// invalid.
+func (r *IasCredentialsReconciler) ReadyzCheck(_ *http.Request) error {
+	_, err := r.GetIasClient()
+	return err
+}
+
+// EventingAuthEvents returns the channel on which events are sent for EventingAuth CRs that must be reconciled again.
+func (r *IasCredentialsReconciler) EventingAuthEvents() <-chan event.GenericEvent {
+	return r.eventingAuthEvents
+}
+
+func (r *IasCredentialsReconciler) enqueueAllEventingAuths(ctx context.Context) error {
+	// replicas that aren't the leader don't run the EventingAuth controller, which reconciles all CRs anyway once it is started
+	if !r.isElected() {
+		return nil
+	}
+	eventingAuths := &eamapiv1alpha1.EventingAuthList{}
+	if err := r.Client.List(ctx, eventingAuths); err != nil {
+		return errors.Wrap(err, "failed to list EventingAuth resources")
+	}
+	for i := range eventingAuths.Items {
+		select {
+		case r.eventingAuthEvents <- event.GenericEvent{Object: &eventingAuths.Items[i]}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+func (r *IasCredentialsReconciler) isElected() bool {
+	if r.elected == nil {
+		return true
+	}
+	select {
+	case <-r.elected:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r *IasCredentialsReconciler) isIasSecret(o kpkgclient.Object) bool {
+	isCredentialsSecret := o.GetNamespace() == r.secretNamespace && o.GetName() == r.secretName
+	isCABundleSecret := r.caBundleSecret.Name != "" && o.GetNamespace() == r.caBundleSecret.Namespace && o.GetName() == r.caBundleSecret.Name
+	return isCredentialsSecret || isCABundleSecret
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *IasCredentialsReconciler) SetupWithManager(mgr kcontrollerruntime.Manager) error {
+	r.elected = mgr.Elected()
+	return kcontrollerruntime.NewControllerManagedBy(mgr).
+		Named("ias-credentials").
+		For(&kcorev1.Secret{}, builder.WithPredicates(predicate.NewPredicateFuncs(r.isIasSecret))).
+		WithOptions(controller.Options{NeedLeaderElection: ptr.To(false)}).
+		Complete(tracing.NewReconciler("IasCredentials", r))
+}
+
+func getIasSecretNamespaceAndNameConfigs() (string, string) {
+	namespace := os.Getenv(iasCredsSecretNamespace)
+	if len(namespace) == 0 {
+		namespace = defaultIasCredsNamespaceName
+	}
+	name := os.Getenv(iasCredsSecretName)
+	if len(name) == 0 {
+		name = DefaultIasCredsSecretName
+	}
+	return namespace, name
+}
@@ -0,0 +1,109 @@
+package controllers_test
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	kapimeta "k8s.io/apimachinery/pkg/api/meta"
+	kpkgclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	eamapiv1alpha1 "github.com/kyma-project/eventing-auth-manager/api/v1alpha1"
+	"github.com/kyma-project/eventing-auth-manager/controllers"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("IAS Credentials Controller", Serial, Ordered, func() {
+	BeforeAll(func() {
+		if !existIasCreds() {
+			stubSuccessfulIasAppCreation()
+		}
+	})
+
+	AfterAll(func() {
+		revertIasNewClientStub()
+	})
+
+	It("should be ready when the IAS credentials secret exists", func() {
+		verifyIasCredentialsReady()
+	})
+
+	It("should not be ready when the IAS credentials secret is deleted", func() {
+		deleteIasCredsSecret()
+		Eventually(func(g Gomega) {
+			g.Expect(iasCredentialsReconciler.ReadyzCheck(nil)).To(HaveOccurred())
+		}, defaultTimeout).Should(Succeed())
+
+		// restore the IAS credentials secret for the following tests
+		if existIasCreds() {
+			createIasCredsSecret(iasURL, iasUsername, iasPassword)
+		} else {
+			createIasCredsSecret(iasURL, uuid.New().String(), iasPassword)
+		}
+		verifyIasCredentialsReady()
+	})
+
+	It("should report invalid IAS credentials in the readiness check and the status of EventingAuth CRs", func() {
+		crName := generateCrName()
+		createKubeconfigSecret(crName)
+		stubSuccessfulSkrSecretCreation()
+		eventingAuth := createEventingAuth(crName)
+		DeferCleanup(func() {
+			deleteEventingAuthAndVerify(eventingAuth)
+			deleteKubeconfigSecret(crName)
+			revertSkrNewClientStub()
+		})
+		verifyEventingAuthStatusReady(eventingAuth)
+
+		upsertIasCredsSecret("http://"+crName, iasUsername, iasPassword)
+		Eventually(func(g Gomega) {
+			g.Expect(iasCredentialsReconciler.ReadyzCheck(nil)).To(MatchError(ContainSubstring("must be an https URL")))
+		}, defaultTimeout).Should(Succeed())
+		Eventually(func(g Gomega) {
+			e := eamapiv1alpha1.EventingAuth{}
+			g.Expect(k8sClient.Get(context.TODO(), kpkgclient.ObjectKeyFromObject(eventingAuth), &e)).Should(Succeed())
+			g.Expect(e.Status.State).To(Equal(eamapiv1alpha1.StateNotReady))
+			g.Expect(kapimeta.FindStatusCondition(e.Status.Conditions, string(eamapiv1alpha1.ConditionApplicationReady))).To(
+				HaveField("Reason", eamapiv1alpha1.ConditionReasonIASCredentialsInvalid))
+		}, defaultTimeout).Should(Succeed())
+
+		// restore the IAS credentials secret for the following tests
+		if existIasCreds() {
+			upsertIasCredsSecret(iasURL, iasUsername, iasPassword)
+		} else {
+			upsertIasCredsSecret(iasURL, uuid.New().String(), iasPassword)
+		}
+		verifyIasCredentialsReady()
+		verifyEventingAuthStatusReady(eventingAuth)
+	})
+
+	It("should cache the result of the IAS readiness check until the IAS client is replaced", func() {
+		pings := &atomic.Int32{}
+		stubIasAppCreation(pingCountingIasClientStub{pings: pings})
+		DeferCleanup(stubSuccessfulIasAppCreation)
+		checker := controllers.NewIasReadinessChecker(iasCredentialsReconciler, time.Hour)
+
+		Eventually(func(g Gomega) {
+			g.Expect(checker.Check(nil)).To(Succeed())
+			g.Expect(pings.Load()).To(BeNumerically(">", 0))
+		}, defaultTimeout).Should(Succeed())
+		checkedPings := pings.Load()
+		Expect(checker.Check(nil)).To(Succeed())
+		Expect(pings.Load()).To(Equal(checkedPings))
+
+		stubIasAppCreation(pingCountingIasClientStub{pings: pings, pingErr: errIASUnreachable})
+		Eventually(func(g Gomega) {
+			g.Expect(checker.Check(nil)).To(MatchError(errIASUnreachable))
+		}, defaultTimeout).Should(Succeed())
+	})
+})
+
+func verifyIasCredentialsReady() {
+	By("Verifying that the IAS client is initialized")
+	Eventually(func(g Gomega) {
+		g.Expect(iasCredentialsReconciler.ReadyzCheck(nil)).To(Succeed())
+	}, defaultTimeout).Should(Succeed())
+}
@@ -16,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	kcontrollerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -23,19 +24,46 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	eamapiv1alpha1 "github.com/kyma-project/eventing-auth-manager/api/v1alpha1"
+	"github.com/kyma-project/eventing-auth-manager/internal/logging"
+	"github.com/kyma-project/eventing-auth-manager/internal/tracing"
 )
 
+// DefaultKymaOptOutLabel disables eventing for a runtime if set to "true" on its Kyma CR, which removes the EventingAuth CR.
+const DefaultKymaOptOutLabel = "operator.kyma-project.io/eventing-auth-disabled"
+
+// DefaultKymaPropagatedLabels are the labels of the Kyma CR that are copied to the EventingAuth CR by default.
+var DefaultKymaPropagatedLabels = []string{ //nolint:gochecknoglobals // Default of the flag.
+	"kyma-project.io/global-account-id",
+	"kyma-project.io/subaccount-id",
+	"kyma-project.io/region",
+}
+
+// KymaReconcilerOptions contains the configuration of the Kyma reconciler.
+type KymaReconcilerOptions struct {
+	// MaxConcurrentReconciles is the number of Kyma CRs that are reconciled in parallel. Values below 1 use the default of
+	// controller-runtime, which is a single worker.
+	MaxConcurrentReconciles int
+	// PropagatedLabels are the keys of the labels that are copied from the Kyma CR to its EventingAuth CR. A label removed from the
+	// Kyma CR is removed from the EventingAuth CR as well.
+	PropagatedLabels []string
+	// OptOutLabel removes the EventingAuth CR of a Kyma CR on which the label is set to "true". An empty label disables the opt-out.
+	OptOutLabel string
+}
+
 // KymaReconciler reconciles a Kyma resource.
 type KymaReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
 	time.Duration
+	options KymaReconcilerOptions
 }
 
-func NewKymaReconciler(c client.Client, s *runtime.Scheme) *KymaReconciler {
+// NewKymaReconciler creates a reconciler for Kyma CRs.
+func NewKymaReconciler(c client.Client, s *runtime.Scheme, options KymaReconcilerOptions) *KymaReconciler {
 	return &KymaReconciler{
-		Client: c,
-		Scheme: s,
+		Client:  c,
+		Scheme:  s,
+		options: options,
 	}
 }
 
@@ -43,7 +71,9 @@ func NewKymaReconciler(c client.Client, s *runtime.Scheme) *KymaReconciler {
 // +kubebuilder:rbac:groups=operator.kyma-project.io,resources=eventingauths,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=operator.kyma-project.io,resources=eventingauths/status,verbs=get;list
 func (r *KymaReconciler) Reconcile(ctx context.Context, req kcontrollerruntime.Request) (kcontrollerruntime.Result, error) {
-	logger := log.FromContext(ctx)
+	// the name of the Kyma resource is the runtime ID
+	logger := logging.FromContext(ctx, logging.ComponentControllers).WithValues(logging.KeyRuntimeID, req.Name)
+	ctx = log.IntoContext(ctx, logger)
 	logger.Info("Reconciling Kyma resource")
 
 	metadata := &kmetav1.PartialObjectMetadata{}
@@ -57,14 +87,40 @@ func (r *KymaReconciler) Reconcile(ctx context.Context, req kcontrollerruntime.R
 		return kcontrollerruntime.Result{}, client.IgnoreNotFound(err)
 	}
 
-	if err = r.createEventingAuth(ctx, metadata); err != nil {
+	if eamapiv1alpha1.IsPaused(metadata) {
+		logger.Info("Skipping reconciliation of paused Kyma resource", "annotation", eamapiv1alpha1.AnnotationPaused)
+		return kcontrollerruntime.Result{}, nil
+	}
+
+	if r.isOptedOut(metadata) {
+		logger.Info("Eventing is disabled for the runtime, removing EventingAuth resource", "label", r.options.OptOutLabel)
+		return kcontrollerruntime.Result{}, r.deleteEventingAuth(ctx, metadata)
+	}
+
+	if err = r.syncEventingAuth(ctx, metadata); err != nil {
 		return kcontrollerruntime.Result{}, err
 	}
 
 	return kcontrollerruntime.Result{}, nil
 }
 
-func (r *KymaReconciler) createEventingAuth(ctx context.Context, kyma *kmetav1.PartialObjectMetadata) error {
+// deleteEventingAuth deletes the EventingAuth CR of the Kyma CR. The IAS application and the secret on the managed runtime are
+// cleaned up by the finalizer of the EventingAuth CR.
+func (r *KymaReconciler) deleteEventingAuth(ctx context.Context, kyma *kmetav1.PartialObjectMetadata) error {
+	eventingAuth := &eamapiv1alpha1.EventingAuth{
+		ObjectMeta: kmetav1.ObjectMeta{
+			Namespace: kyma.Namespace,
+			Name:      kyma.Name,
+		},
+	}
+	if err := r.Client.Delete(ctx, eventingAuth); err != nil {
+		return errors.Wrap(client.IgnoreNotFound(err), "failed to delete EventingAuth resource")
+	}
+	return nil
+}
+
+// syncEventingAuth creates the EventingAuth CR of the Kyma CR or updates its controller reference and propagated labels.
+func (r *KymaReconciler) syncEventingAuth(ctx context.Context, kyma *kmetav1.PartialObjectMetadata) error {
 	actual := &eamapiv1alpha1.EventingAuth{}
 	err := r.Client.Get(ctx, types.NamespacedName{Namespace: kyma.Namespace, Name: kyma.Name}, actual)
 	if err != nil {
@@ -75,6 +131,7 @@ func (r *KymaReconciler) createEventingAuth(ctx context.Context, kyma *kmetav1.P
 					Name:      kyma.Name,
 				},
 			}
+			r.propagateLabels(kyma, desired)
 			if err = controllerutil.SetControllerReference(kyma, desired, r.Scheme); err != nil {
 				return err
 			}
@@ -86,6 +143,9 @@ func (r *KymaReconciler) createEventingAuth(ctx context.Context, kyma *kmetav1.P
 		}
 		return errors.Wrap(err, "failed to retrieve EventingAuth resource")
 	}
+	if eamapiv1alpha1.IsPaused(actual) {
+		return nil
+	}
 
 	desired := actual.DeepCopy()
 	// remove previous controller ref regardless of currently specified api-version of the controller ref
@@ -108,6 +168,7 @@ func (r *KymaReconciler) createEventingAuth(ctx context.Context, kyma *kmetav1.P
 	if err = controllerutil.SetControllerReference(kyma, desired, r.Scheme); err != nil {
 		return err
 	}
+	r.propagateLabels(kyma, desired)
 	if !reflect.DeepEqual(desired, actual) {
 		err = r.Client.Update(ctx, desired, &client.UpdateOptions{})
 		if err != nil {
@@ -118,15 +179,56 @@ func (r *KymaReconciler) createEventingAuth(ctx context.Context, kyma *kmetav1.P
 	return nil
 }
 
-func reactToCreateOnlyPredicate() predicate.Predicate {
+// propagateLabels copies the propagated labels of the Kyma CR to the EventingAuth CR and removes the propagated labels that are not
+// set on the Kyma CR. Other labels of the EventingAuth CR are kept.
+func (r *KymaReconciler) propagateLabels(kyma kmetav1.Object, eventingAuth kmetav1.Object) {
+	labels := eventingAuth.GetLabels()
+	for _, key := range r.options.PropagatedLabels {
+		value, found := kyma.GetLabels()[key]
+		if !found {
+			delete(labels, key)
+			continue
+		}
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[key] = value
+	}
+	eventingAuth.SetLabels(labels)
+}
+
+// isOptedOut returns true if eventing is disabled for the runtime with the opt-out label of the Kyma CR.
+func (r *KymaReconciler) isOptedOut(kyma kmetav1.Object) bool {
+	return r.options.OptOutLabel != "" && kyma.GetLabels()[r.options.OptOutLabel] == "true"
+}
+
+// hasRelevantChanges returns true if the update of the Kyma CR changes the propagated labels or the opt-out label, or resumes the
+// reconciliation of a paused Kyma CR. Other changes are not relevant, as the EventingAuth CR only depends on the name of the Kyma CR.
+func (r *KymaReconciler) hasRelevantChanges(old, updated kmetav1.Object) bool {
+	if eamapiv1alpha1.IsPaused(old) && !eamapiv1alpha1.IsPaused(updated) {
+		return true
+	}
+	if r.isOptedOut(old) != r.isOptedOut(updated) {
+		return true
+	}
+	for _, key := range r.options.PropagatedLabels {
+		oldValue, oldFound := old.GetLabels()[key]
+		updatedValue, updatedFound := updated.GetLabels()[key]
+		if oldFound != updatedFound || oldValue != updatedValue {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *KymaReconciler) relevantChangesPredicate() predicate.Predicate {
 	return predicate.Funcs{
 		DeleteFunc: func(e event.DeleteEvent) bool {
 			// Deleting a kyma CR will automatically create a delete event for the EAM resource using the kubernetes garbage collection
 			return false
 		},
 		UpdateFunc: func(e event.UpdateEvent) bool {
-			// Updating a kyma CR is not relevant as the only information required for EAM is the name of the CR
-			return false
+			return r.hasRelevantChanges(e.ObjectOld, e.ObjectNew)
 		},
 	}
 }
@@ -137,7 +239,8 @@ func (r *KymaReconciler) SetupWithManager(mgr kcontrollerruntime.Manager) error
 		Named("eam-reconciler").
 		WatchesMetadata(&klmapiv1beta2.Kyma{}, &handler.EnqueueRequestForObject{}).
 		// For(&klmapiv1beta2.Kyma{}).
-		WithEventFilter(reactToCreateOnlyPredicate()).
+		WithEventFilter(r.relevantChangesPredicate()).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.options.MaxConcurrentReconciles}).
 		// Owns(&eamapiv1alpha1.EventingAuth{}).
-		Complete(r)
+		Complete(tracing.NewReconciler("Kyma", r))
 }
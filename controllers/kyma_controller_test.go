@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	klmapiv1beta2 "github.com/kyma-project/lifecycle-manager/api/v1beta2"
 	kcorev1 "k8s.io/api/core/v1"
@@ -59,6 +60,33 @@ var _ = Describe("Kyma Controller", Serial, Ordered, func() {
 			deleteKymaResource(kyma)
 		})
 	})
+	Context("Pausing Kyma CR", func() {
+		It("should create EA CR only after the reconciliation is resumed", func() {
+			// given
+			kyma = createKymaResourceWithAnnotations(crName, map[string]string{eamapiv1alpha1.AnnotationPaused: "true"})
+
+			// then
+			By("Verifying that no EventingAuth CR is created while paused")
+			Consistently(func(g Gomega) {
+				err := k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: kyma.Namespace, Name: kyma.Name}, &eamapiv1alpha1.EventingAuth{})
+				g.Expect(kapierrors.IsNotFound(err)).To(BeTrue())
+			}, 5*time.Second).Should(Succeed())
+
+			// when
+			By("Resuming reconciliation of Kyma CR")
+			Eventually(func(g Gomega) {
+				latest := &klmapiv1beta2.Kyma{}
+				g.Expect(k8sClient.Get(context.TODO(), kpkgclient.ObjectKeyFromObject(kyma), latest)).Should(Succeed())
+				delete(latest.Annotations, eamapiv1alpha1.AnnotationPaused)
+				g.Expect(k8sClient.Update(context.TODO(), latest)).Should(Succeed())
+			}, defaultTimeout).Should(Succeed())
+
+			// then
+			verifyEventingAuth(*kyma)
+
+			deleteKymaResource(kyma)
+		})
+	})
 	Context("Reconciling Kyma CR", func() {
 		It("should update EA CR", func() {
 			createEventingAuthWithWrongOwnerRef(crName)
@@ -66,11 +94,90 @@ var _ = Describe("Kyma Controller", Serial, Ordered, func() {
 
 			verifyEventingAuth(*kyma)
 
+			deleteKymaResource(kyma)
+		})
+	})
+	Context("Updating labels of Kyma CR", func() {
+		It("should propagate labels to EA CR", func() {
+			// given
+			kyma = createKymaResourceWithLabels(crName, map[string]string{
+				"kyma-project.io/global-account-id": "global-account-id",
+				"kyma-project.io/region":            "eu-central-1",
+				"not-propagated":                    "value",
+			})
+			verifyEventingAuth(*kyma)
+			verifyEventingAuthLabels(*kyma, map[string]string{
+				"kyma-project.io/global-account-id": "global-account-id",
+				"kyma-project.io/region":            "eu-central-1",
+			})
+
+			// when
+			updateKymaLabels(kyma, func(labels map[string]string) {
+				labels["kyma-project.io/subaccount-id"] = "subaccount-id"
+				delete(labels, "kyma-project.io/region")
+			})
+
+			// then
+			verifyEventingAuthLabels(*kyma, map[string]string{
+				"kyma-project.io/global-account-id": "global-account-id",
+				"kyma-project.io/subaccount-id":     "subaccount-id",
+			})
+
+			deleteKymaResource(kyma)
+		})
+		It("should delete EA CR when eventing is disabled and recreate it when enabled", func() {
+			// given
+			kyma = createKymaResource(crName)
+			verifyEventingAuth(*kyma)
+
+			// when
+			By("Disabling eventing for the runtime")
+			updateKymaLabels(kyma, func(labels map[string]string) {
+				labels[controllers.DefaultKymaOptOutLabel] = "true"
+			})
+
+			// then
+			Eventually(func(g Gomega) {
+				err := k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: kyma.Namespace, Name: kyma.Name}, &eamapiv1alpha1.EventingAuth{})
+				g.Expect(kapierrors.IsNotFound(err)).To(BeTrue())
+			}, defaultTimeout).Should(Succeed())
+
+			// when
+			By("Enabling eventing for the runtime")
+			updateKymaLabels(kyma, func(labels map[string]string) {
+				delete(labels, controllers.DefaultKymaOptOutLabel)
+			})
+
+			// then
+			verifyEventingAuth(*kyma)
+
 			deleteKymaResource(kyma)
 		})
 	})
 })
 
+func verifyEventingAuthLabels(kyma klmapiv1beta2.Kyma, wantLabels map[string]string) {
+	By(fmt.Sprintf("Verifying labels of EventingAuth CR %s", kyma.Name))
+	Eventually(func(g Gomega) {
+		eventingAuth := &eamapiv1alpha1.EventingAuth{}
+		g.Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: kyma.Namespace, Name: kyma.Name}, eventingAuth)).Should(Succeed())
+		g.Expect(eventingAuth.Labels).To(Equal(wantLabels))
+	}, defaultTimeout).Should(Succeed())
+}
+
+func updateKymaLabels(kyma *klmapiv1beta2.Kyma, update func(labels map[string]string)) {
+	By(fmt.Sprintf("Updating labels of Kyma CR %s", kyma.Name))
+	Eventually(func(g Gomega) {
+		latest := &klmapiv1beta2.Kyma{}
+		g.Expect(k8sClient.Get(context.TODO(), kpkgclient.ObjectKeyFromObject(kyma), latest)).Should(Succeed())
+		if latest.Labels == nil {
+			latest.Labels = map[string]string{}
+		}
+		update(latest.Labels)
+		g.Expect(k8sClient.Update(context.TODO(), latest)).Should(Succeed())
+	}, defaultTimeout).Should(Succeed())
+}
+
 func verifyEventingAuth(kyma klmapiv1beta2.Kyma) {
 	nsName := types.NamespacedName{Namespace: kyma.Namespace, Name: kyma.Name}
 	By(fmt.Sprintf("Verifying Kyma CR %s", nsName.String()))
@@ -119,6 +226,44 @@ func createKymaResource(name string) *klmapiv1beta2.Kyma {
 	return &kyma
 }
 
+func createKymaResourceWithAnnotations(name string, annotations map[string]string) *klmapiv1beta2.Kyma {
+	kyma := klmapiv1beta2.Kyma{
+		ObjectMeta: kmetav1.ObjectMeta{
+			Name:        name,
+			Namespace:   skr.KcpNamespace,
+			Annotations: annotations,
+		},
+		Spec: klmapiv1beta2.KymaSpec{
+			Modules: []klmapiv1beta2.Module{{Name: "nats"}},
+			Channel: "alpha",
+		},
+	}
+
+	By("Creating Kyma CR with annotations")
+	Expect(k8sClient.Create(context.TODO(), &kyma)).Should(Succeed())
+
+	return &kyma
+}
+
+func createKymaResourceWithLabels(name string, labels map[string]string) *klmapiv1beta2.Kyma {
+	kyma := klmapiv1beta2.Kyma{
+		ObjectMeta: kmetav1.ObjectMeta{
+			Name:      name,
+			Namespace: skr.KcpNamespace,
+			Labels:    labels,
+		},
+		Spec: klmapiv1beta2.KymaSpec{
+			Modules: []klmapiv1beta2.Module{{Name: "nats"}},
+			Channel: "alpha",
+		},
+	}
+
+	By("Creating Kyma CR with labels")
+	Expect(k8sClient.Create(context.TODO(), &kyma)).Should(Succeed())
+
+	return &kyma
+}
+
 func deleteKymaResource(kyma *klmapiv1beta2.Kyma) {
 	By(fmt.Sprintf("Deleting Kyma %s", kyma.Name))
 	Expect(k8sClient.Delete(context.TODO(), kyma)).Should(Succeed())
@@ -154,6 +299,23 @@ func createIasCredsSecret(url, username, password string) *kcorev1.Secret {
 	return &kubeconfigSecret
 }
 
+func upsertIasCredsSecret(url, username, password string) {
+	By("Updating IAS credentials secret")
+	s := &kcorev1.Secret{}
+	err := k8sClient.Get(context.TODO(), kpkgclient.ObjectKey{Name: controllers.DefaultIasCredsSecretName, Namespace: skr.KcpNamespace}, s)
+	if kapierrors.IsNotFound(err) {
+		createIasCredsSecret(url, username, password)
+		return
+	}
+	Expect(err).NotTo(HaveOccurred())
+	s.Data = map[string][]byte{
+		"url":      []byte(url),
+		"username": []byte(username),
+		"password": []byte(password),
+	}
+	Expect(k8sClient.Update(context.TODO(), s)).Should(Succeed())
+}
+
 func deleteIasCredsSecret() {
 	s := &kcorev1.Secret{
 		ObjectMeta: kmetav1.ObjectMeta{
@@ -0,0 +1,155 @@
+package controllers
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kpkgclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	eamapiv1alpha1 "github.com/kyma-project/eventing-auth-manager/api/v1alpha1"
+	eamias "github.com/kyma-project/eventing-auth-manager/internal/ias"
+	"github.com/kyma-project/eventing-auth-manager/internal/logging"
+)
+
+// DefaultOrphanedApplicationNamePattern matches the runtime IDs of the managed runtimes, which are used as names of the IAS applications.
+const DefaultOrphanedApplicationNamePattern = `^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`
+
+// OrphanedApplicationCollectorConfig configures the garbage collection of orphaned IAS applications.
+type OrphanedApplicationCollectorConfig struct {
+	// Interval between two collection runs.
+	Interval time.Duration
+	// GracePeriod is the minimum age of an application before it is considered orphaned. This prevents the deletion of applications
+	// whose EventingAuth CR is not yet visible to the collector.
+	GracePeriod time.Duration
+	// DryRun only reports the orphaned applications instead of deleting them.
+	DryRun bool
+	// InstanceID identifies the operator instance in the ownership recorded in the applications. Only applications with recorded
+	// ownership of this instance are collected.
+	InstanceID string
+	// NamePattern optionally restricts the collection to applications with matching names.
+	NamePattern *regexp.Regexp
+	// DescriptionPattern optionally restricts the collection to applications with matching descriptions.
+	DescriptionPattern *regexp.Regexp
+	// GlobalAccountID restricts the collection to the applications of the global account the operator creates applications for.
+	GlobalAccountID string
+}
+
+// OrphanedApplicationCollector periodically deletes IAS applications that were created by the operator, but whose EventingAuth CR
+// no longer exists. Such applications leak if an EventingAuth CR is removed without running the finalizer or if the deletion failed halfway.
+type OrphanedApplicationCollector struct {
+	kpkgclient.Client
+	iasCredentials *IasCredentialsReconciler
+	config         OrphanedApplicationCollectorConfig
+	now            func() time.Time
+}
+
+func NewOrphanedApplicationCollector(c kpkgclient.Client, iasCredentials *IasCredentialsReconciler, config OrphanedApplicationCollectorConfig) *OrphanedApplicationCollector {
+	return &OrphanedApplicationCollector{
+		Client:         c,
+		iasCredentials: iasCredentials,
+		config:         config,
+		now:            time.Now,
+	}
+}
+
+// Start runs the collection periodically until the context is cancelled.
+func (c *OrphanedApplicationCollector) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if _, err := c.Collect(ctx); err != nil {
+			collectorLogger(ctx).Error(err, "Failed to collect orphaned IAS applications")
+		}
+	}, c.config.Interval)
+	return nil
+}
+
+// NeedLeaderElection ensures that only the leading instance deletes orphaned applications.
+func (c *OrphanedApplicationCollector) NeedLeaderElection() bool {
+	return true
+}
+
+// Collect deletes all orphaned IAS applications, or only reports them in dry-run mode, and returns the orphaned applications.
+func (c *OrphanedApplicationCollector) Collect(ctx context.Context) ([]eamias.ApplicationInfo, error) {
+	logger := collectorLogger(ctx)
+	ctx = log.IntoContext(ctx, logger)
+
+	iasClient, err := c.iasCredentials.GetIasClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// The applications are listed before the EventingAuth CRs, so that an application created in between is owned by a listed CR.
+	apps, err := iasClient.ListApplications(ctx, eamias.Filter{})
+	if err != nil {
+		return nil, err
+	}
+
+	eventingAuths := &eamapiv1alpha1.EventingAuthList{}
+	if err := c.List(ctx, eventingAuths); err != nil {
+		return nil, errors.Wrap(err, "failed to list EventingAuth CRs")
+	}
+	owners := make(map[types.NamespacedName]struct{}, len(eventingAuths.Items))
+	for _, eventingAuth := range eventingAuths.Items {
+		owners[types.NamespacedName{Namespace: eventingAuth.Namespace, Name: eventingAuth.Name}] = struct{}{}
+	}
+
+	orphans := make([]eamias.ApplicationInfo, 0)
+	for _, app := range apps {
+		if !c.isCreatedByOperator(app) {
+			continue
+		}
+		if _, hasOwner := owners[types.NamespacedName{Namespace: app.Owner.KcpNamespace, Name: app.Owner.RuntimeID}]; hasOwner {
+			continue
+		}
+		// without the creation time, the age of the application is unknown, so it could be an application whose creation is in progress
+		if app.Created.IsZero() {
+			logger.Info("Skipping orphaned IAS application with unknown creation time", "name", app.Name, "id", app.ID)
+			continue
+		}
+		if c.now().Sub(app.Created) < c.config.GracePeriod {
+			logger.V(1).Info("Skipping orphaned IAS application within grace period", "name", app.Name, "id", app.ID, "created", app.Created)
+			continue
+		}
+		orphans = append(orphans, app)
+
+		if c.config.DryRun {
+			logger.Info("Found orphaned IAS application, skipping deletion in dry-run mode", "name", app.Name, "id", app.ID, "created", app.Created)
+			continue
+		}
+		appCtx := log.IntoContext(ctx, logger.WithValues(logging.KeyRuntimeID, app.Owner.RuntimeID, logging.KeyApplicationID, app.ID))
+		if err := iasClient.DeleteApplicationByID(appCtx, app.ID); err != nil {
+			return orphans, errors.Wrapf(err, "failed to delete orphaned IAS application %s", app.ID)
+		}
+		logger.Info("Deleted orphaned IAS application", "name", app.Name, "id", app.ID, "created", app.Created)
+	}
+	return orphans, nil
+}
+
+// collectorLogger returns the logger of the collector, which uses the log level of the controllers.
+func collectorLogger(ctx context.Context) logr.Logger {
+	return logging.FromContext(ctx, logging.ComponentControllers).WithName("orphaned-application-collector")
+}
+
+// isCreatedByOperator returns true if the application has recorded ownership of this operator instance, matches all configured
+// patterns and belongs to the configured global account. Applications without matching ownership are never collected, nor are
+// applications that were released when their EventingAuth CR was deleted with the Orphan deletion policy.
+func (c *OrphanedApplicationCollector) isCreatedByOperator(app eamias.ApplicationInfo) bool {
+	if app.Owner == nil || app.Owner.Released || app.Owner.InstanceID != c.config.InstanceID {
+		return false
+	}
+	if c.config.GlobalAccountID != "" && app.GlobalAccountID != c.config.GlobalAccountID {
+		return false
+	}
+	if c.config.NamePattern != nil && !c.config.NamePattern.MatchString(app.Name) {
+		return false
+	}
+	if c.config.DescriptionPattern != nil && !c.config.DescriptionPattern.MatchString(app.Description) {
+		return false
+	}
+	return true
+}
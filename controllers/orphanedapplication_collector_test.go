@@ -0,0 +1,109 @@
+package controllers_test
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+
+	eamapiv1alpha1 "github.com/kyma-project/eventing-auth-manager/api/v1alpha1"
+	"github.com/kyma-project/eventing-auth-manager/controllers"
+	eamias "github.com/kyma-project/eventing-auth-manager/internal/ias"
+	"github.com/kyma-project/eventing-auth-manager/internal/skr"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Orphaned IAS application collector", Serial, Ordered, func() {
+	var (
+		eventingAuth *eamapiv1alpha1.EventingAuth
+		crName       string
+		ownedApp     eamias.ApplicationInfo
+		orphanedApp  eamias.ApplicationInfo
+		recentApp    eamias.ApplicationInfo
+		// applications with unknown creation time could be in creation and are never collected
+		unknownAgeApp eamias.ApplicationInfo
+		otherGaApp    eamias.ApplicationInfo
+		// applications of other operator instances or without ownership are never collected
+		otherInstanceApp eamias.ApplicationInfo
+		foreignApp       eamias.ApplicationInfo
+		// applications kept with the Orphan deletion policy are released and never collected
+		releasedApp eamias.ApplicationInfo
+		iasStub     existingAppsIasClientStub
+	)
+
+	BeforeAll(func() {
+		crName = generateCrName()
+		createKubeconfigSecret(crName)
+		stubSuccessfulSkrSecretCreation()
+
+		old := time.Now().Add(-48 * time.Hour)
+		ownedApp = newTestApplicationInfo(crName, "GAID", testInstanceID, old)
+		orphanedApp = newTestApplicationInfo(uuid.New().String(), "GAID", testInstanceID, old)
+		recentApp = newTestApplicationInfo(uuid.New().String(), "GAID", testInstanceID, time.Now())
+		unknownAgeApp = newTestApplicationInfo(uuid.New().String(), "GAID", testInstanceID, time.Time{})
+		otherGaApp = newTestApplicationInfo(uuid.New().String(), "other", testInstanceID, old)
+		otherInstanceApp = newTestApplicationInfo(uuid.New().String(), "GAID", "other-instance", old)
+		foreignApp = eamias.ApplicationInfo{ID: uuid.New().String(), Name: uuid.New().String(), GlobalAccountID: "GAID", Created: old}
+		releasedApp = newTestApplicationInfo(uuid.New().String(), "GAID", testInstanceID, old)
+		releasedApp.Owner.Released = true
+		iasStub = newExistingAppsIasClientStub(ownedApp, orphanedApp, recentApp, unknownAgeApp, otherGaApp, otherInstanceApp, foreignApp, releasedApp)
+		stubIasAppCreation(iasStub)
+
+		eventingAuth = createEventingAuth(crName)
+	})
+
+	AfterAll(func() {
+		deleteEventingAuthAndVerify(eventingAuth)
+		deleteKubeconfigSecret(crName)
+		revertIasNewClientStub()
+		revertSkrNewClientStub()
+	})
+
+	It("should only report orphaned applications in dry-run mode", func() {
+		collector := newTestOrphanedApplicationCollector(true)
+
+		Eventually(func(g Gomega) {
+			orphans, err := collector.Collect(context.TODO())
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(orphans).To(ConsistOf(orphanedApp))
+		}, defaultTimeout).Should(Succeed())
+		Expect(iasStub.DeletedIDs()).To(BeEmpty())
+	})
+
+	It("should delete orphaned applications", func() {
+		collector := newTestOrphanedApplicationCollector(false)
+
+		Eventually(func(g Gomega) {
+			orphans, err := collector.Collect(context.TODO())
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(orphans).To(ConsistOf(orphanedApp))
+		}, defaultTimeout).Should(Succeed())
+		Expect(iasStub.DeletedIDs()).To(ConsistOf(orphanedApp.ID))
+		Expect(iasStub.DeletedIDs()).NotTo(ContainElement(releasedApp.ID))
+		Expect(iasStub.DeletedIDs()).NotTo(ContainElement(unknownAgeApp.ID))
+	})
+})
+
+func newTestOrphanedApplicationCollector(dryRun bool) *controllers.OrphanedApplicationCollector {
+	return controllers.NewOrphanedApplicationCollector(k8sClient, iasCredentialsReconciler, controllers.OrphanedApplicationCollectorConfig{
+		Interval:        time.Hour,
+		GracePeriod:     24 * time.Hour,
+		DryRun:          dryRun,
+		InstanceID:      testInstanceID,
+		NamePattern:     regexp.MustCompile(controllers.DefaultOrphanedApplicationNamePattern),
+		GlobalAccountID: "GAID",
+	})
+}
+
+func newTestApplicationInfo(runtimeID, globalAccountID, instanceID string, created time.Time) eamias.ApplicationInfo {
+	return eamias.ApplicationInfo{
+		ID:              uuid.New().String(),
+		Name:            runtimeID,
+		GlobalAccountID: globalAccountID,
+		Created:         created,
+		Owner:           &eamias.Ownership{RuntimeID: runtimeID, KcpNamespace: skr.KcpNamespace, InstanceID: instanceID},
+	}
+}
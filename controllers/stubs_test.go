@@ -2,8 +2,18 @@ package controllers_test
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/google/uuid"
 	kcorev1 "k8s.io/api/core/v1"
@@ -16,12 +26,18 @@ import (
 )
 
 var (
-	originalNewIasClientFunc    func(iasTenantUrl, user, password string) (eamias.Client, error)
-	originalReadCredentialsFunc func(namespace, name string, k8sClient client.Client) (*eamias.Credentials, error)
-	originalNewSkrClientFunc    func(k8sClient client.Client, targetClusterId string) (skr.Client, error)
+	originalNewIasClientFunc func(iasTenantUrl, user, password string, options eamias.ClientOptions) (eamias.Client, error)
+	originalNewSkrClientFunc func(k8sClient client.Client, targetClusterId string) (skr.Client, error)
 
 	errIASApplicationCreation = errors.New("stubbed IAS application creation error")
 	errSKRSecretCreation      = errors.New("stubbed skr secret creation error")
+	errIASApplicationDeletion = errors.New("stubbed IAS application deletion error")
+	errIASUnreachable         = errors.New("stubbed IAS unreachable error")
+	// errIASApplicationUnauthorized is classified as rejected credentials.
+	errIASApplicationUnauthorized = fmt.Errorf("stubbed IAS application creation error: %w", eamias.ErrUnauthorized)
+	duplicateAppIDs               = []string{"duplicate-app-id-1", "duplicate-app-id-2"}
+	// errIASApplicationCreationWithSecret contains a client secret that must not reach the status of the EventingAuth CR.
+	errIASApplicationCreationWithSecret = errors.New(`stubbed IAS application creation error: {"client_secret":"test-client-secret"}`)
 )
 
 func stubSuccessfulIasAppCreation() {
@@ -35,15 +51,29 @@ func stubFailedIasAppCreation() {
 }
 
 func stubIasAppCreation(c eamias.Client) {
-	// The IAS client is initialized once in the "Reconcile" method of the controller. To update the IAS client stub by forcing a replacement, we need to
-	// update the IAS credentials stub so that the implemented logic assumes that the IAS credentials have been rotated and forces a reinitialization of the IAS client.
-	replaceIasReadCredentialsWithStub(eamias.Credentials{URL: iasURL, Username: uuid.New().String(), Password: iasPassword})
+	// The IAS client is only rebuilt by the IAS credentials reconciler when the IAS credentials secret changes. To update the IAS client stub by forcing a replacement,
+	// we need to update the IAS credentials secret so that the reconciler assumes that the IAS credentials have been rotated and forces a reinitialization of the IAS client.
 	replaceIasNewIasClientWithStub(c)
+	upsertIasCredsSecret(iasURL, uuid.New().String(), iasPassword)
 }
 
 type iasClientStub struct{}
 
-func (i iasClientStub) CreateApplication(_ context.Context, name, _ string) (eamias.Application, error) {
+func (i iasClientStub) CreateApplication(_ context.Context, name string, config eamias.ApplicationConfig) (eamias.Application, error) {
+	if config.CredentialType == eamias.CredentialTypeCertificate {
+		tlsCert, tlsKey, err := newTestCertificate(name)
+		if err != nil {
+			return eamias.Application{}, err
+		}
+		return eamias.NewApplicationWithCertificate(
+			fmt.Sprintf("id-for-%s", name),
+			fmt.Sprintf("client-id-for-%s", name),
+			tlsCert,
+			tlsKey,
+			"https://test-token-url.com/token",
+			"https://test-token-url.com/certs",
+		), nil
+	}
 	return eamias.NewApplication(
 		fmt.Sprintf("id-for-%s", name),
 		fmt.Sprintf("client-id-for-%s", name),
@@ -53,40 +83,205 @@ func (i iasClientStub) CreateApplication(_ context.Context, name, _ string) (eam
 	), nil
 }
 
-func (i iasClientStub) DeleteApplication(_ context.Context, _ string) error {
+// newTestCertificate returns a self-signed client certificate valid for a year, so that the credentials are not renewed.
+func newTestCertificate(commonName string) ([]byte, []byte, error) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(365 * 24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), nil
+}
+
+func (i iasClientStub) UpdateApplication(_ context.Context, _ string, _ eamias.ApplicationConfig) (bool, error) {
+	return false, nil
+}
+
+func (i iasClientStub) DeleteApplication(_ context.Context, _ string, _ eamias.Ownership) error {
+	return nil
+}
+
+func (i iasClientStub) DeleteApplicationByID(_ context.Context, _ string) error {
+	return nil
+}
+
+func (i iasClientStub) ReleaseApplication(_ context.Context, _ string, _ eamias.Ownership) error {
+	return nil
+}
+
+func (i iasClientStub) ListApplications(_ context.Context, _ eamias.Filter) ([]eamias.ApplicationInfo, error) {
+	return []eamias.ApplicationInfo{}, nil
+}
+
+// RegenerateCredentials returns credentials that differ from the created ones, so that tests can verify that the secret on the
+// target cluster is updated.
+func (i iasClientStub) RegenerateCredentials(ctx context.Context, name string, config eamias.ApplicationConfig) (eamias.Application, error) {
+	if config.CredentialType == eamias.CredentialTypeCertificate {
+		return i.CreateApplication(ctx, name, config)
+	}
+	return eamias.NewApplication(
+		fmt.Sprintf("id-for-%s", name),
+		fmt.Sprintf("regenerated-client-id-for-%s", name),
+		uuid.NewString(),
+		"https://test-token-url.com/token",
+		"https://test-token-url.com/certs",
+	), nil
+}
+
+func (i iasClientStub) RevokeCredentials(_ context.Context, _ string, _ eamias.Application) error {
 	return nil
 }
 
+func (i iasClientStub) GetApplicationByID(_ context.Context, _ string) (*eamias.ApplicationInfo, error) {
+	return nil, nil //nolint:nilnil
+}
+
+func (i iasClientStub) GetApplicationByClientID(_ context.Context, _ string) (*eamias.ApplicationInfo, error) {
+	return nil, nil //nolint:nilnil
+}
+
+func (i iasClientStub) ResolveDuplicateApplications(_ context.Context, _ string, _ eamias.DuplicatePolicy, _ eamias.Ownership) (string, error) {
+	return "", nil
+}
+
 func (i iasClientStub) GetCredentials() *eamias.Credentials {
 	return &eamias.Credentials{}
 }
 
+func (i iasClientStub) Ping(_ context.Context) error {
+	return nil
+}
+
+// pingCountingIasClientStub counts the probes of the readiness check.
+type pingCountingIasClientStub struct {
+	iasClientStub
+	pings   *atomic.Int32
+	pingErr error
+}
+
+func (i pingCountingIasClientStub) Ping(_ context.Context) error {
+	i.pings.Add(1)
+	return i.pingErr
+}
+
+func stubFailedIasAppDeletion() {
+	By("Stubbing IAS application deletion to fail")
+	stubIasAppCreation(appDeletionFailsIasClientStub{})
+}
+
+type appDeletionFailsIasClientStub struct {
+	iasClientStub
+}
+
+func (i appDeletionFailsIasClientStub) DeleteApplication(_ context.Context, _ string, _ eamias.Ownership) error {
+	return errIASApplicationDeletion
+}
+
 type appCreationFailsIasClientStub struct {
 	iasClientStub
 }
 
-func (i appCreationFailsIasClientStub) CreateApplication(_ context.Context, _, _ string) (eamias.Application, error) {
+func (i appCreationFailsIasClientStub) CreateApplication(_ context.Context, _ string, _ eamias.ApplicationConfig) (eamias.Application, error) {
 	return eamias.Application{}, errIASApplicationCreation
 }
 
-func replaceIasReadCredentialsWithStub(credentials eamias.Credentials) {
-	eamias.ReadCredentials = func(namespace, name string, k8sClient client.Client) (*eamias.Credentials, error) {
-		return &credentials, nil
-	}
+func stubIasAppCreationFailingWithSecret() {
+	By("Stubbing IAS application creation to fail with an error containing a client secret")
+	stubIasAppCreation(secretLeakingIasClientStub{})
+}
+
+type secretLeakingIasClientStub struct {
+	iasClientStub
+}
+
+func (i secretLeakingIasClientStub) CreateApplication(_ context.Context, _ string, _ eamias.ApplicationConfig) (eamias.Application, error) {
+	return eamias.Application{}, errIASApplicationCreationWithSecret
+}
+
+func stubUnauthorizedIasAppCreation() {
+	By("Stubbing IAS application creation to fail with rejected credentials")
+	stubIasAppCreation(unauthorizedIasClientStub{})
+}
+
+type unauthorizedIasClientStub struct {
+	iasClientStub
+}
+
+func (i unauthorizedIasClientStub) CreateApplication(_ context.Context, _ string, _ eamias.ApplicationConfig) (eamias.Application, error) {
+	return eamias.Application{}, errIASApplicationUnauthorized
+}
+
+func stubDuplicateIasApps() {
+	By("Stubbing IAS application creation to fail with duplicate applications")
+	stubIasAppCreation(duplicateAppsIasClientStub{})
+}
+
+// duplicateAppsIasClientStub simulates multiple applications with the same name that can't be resolved.
+type duplicateAppsIasClientStub struct {
+	iasClientStub
+}
+
+func (i duplicateAppsIasClientStub) CreateApplication(_ context.Context, name string, _ eamias.ApplicationConfig) (eamias.Application, error) {
+	return eamias.Application{}, &eamias.DuplicateApplicationsError{Name: name, IDs: duplicateAppIDs}
+}
+
+func (i duplicateAppsIasClientStub) ResolveDuplicateApplications(_ context.Context, name string, _ eamias.DuplicatePolicy, _ eamias.Ownership) (string, error) {
+	return "", &eamias.DuplicateApplicationsError{Name: name, IDs: duplicateAppIDs}
+}
+
+// existingAppsIasClientStub returns the given applications when listing applications and records the IDs of deleted applications.
+type existingAppsIasClientStub struct {
+	iasClientStub
+	apps       []eamias.ApplicationInfo
+	mu         *sync.Mutex
+	deletedIDs *[]string
+}
+
+func newExistingAppsIasClientStub(apps ...eamias.ApplicationInfo) existingAppsIasClientStub {
+	return existingAppsIasClientStub{apps: apps, mu: &sync.Mutex{}, deletedIDs: &[]string{}}
+}
+
+func (i existingAppsIasClientStub) ListApplications(_ context.Context, _ eamias.Filter) ([]eamias.ApplicationInfo, error) {
+	return i.apps, nil
+}
+
+func (i existingAppsIasClientStub) DeleteApplicationByID(_ context.Context, id string) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	*i.deletedIDs = append(*i.deletedIDs, id)
+	return nil
+}
+
+func (i existingAppsIasClientStub) DeletedIDs() []string {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return append([]string{}, *i.deletedIDs...)
 }
 
 func storeOriginalsOfStubbedFunctions() {
-	originalReadCredentialsFunc = eamias.ReadCredentials
 	originalNewIasClientFunc = eamias.NewClient
 	originalNewSkrClientFunc = skr.NewClient
 }
 
-func revertReadCredentialsStub() {
-	eamias.ReadCredentials = originalReadCredentialsFunc
-}
-
 func revertIasNewClientStub() {
 	eamias.NewClient = originalNewIasClientFunc
+	if existIasCreds() {
+		// rotate the IAS credentials secret back to the real credentials to force the reinitialization of the real IAS client
+		upsertIasCredsSecret(iasURL, iasUsername, iasPassword)
+	}
 }
 
 func revertSkrNewClientStub() {
@@ -94,7 +289,7 @@ func revertSkrNewClientStub() {
 }
 
 func replaceIasNewIasClientWithStub(c eamias.Client) {
-	eamias.NewClient = func(iasTenantUrl, user, password string) (eamias.Client, error) {
+	eamias.NewClient = func(iasTenantUrl, user, password string, _ eamias.ClientOptions) (eamias.Client, error) {
 		return c, nil
 	}
 }
@@ -107,8 +302,12 @@ func (s skrClientStub) CreateSecret(_ context.Context, app eamias.Application) (
 	return app.ToSecret(skr.ApplicationSecretName, skr.ApplicationSecretNamespace), nil
 }
 
-func (s skrClientStub) HasApplicationSecret(_ context.Context) (bool, error) {
-	return false, nil
+func (s skrClientStub) UpdateSecret(_ context.Context, app eamias.Application) (kcorev1.Secret, error) {
+	return app.ToSecret(skr.ApplicationSecretName, skr.ApplicationSecretNamespace), nil
+}
+
+func (s skrClientStub) GetApplicationSecret(_ context.Context) (*kcorev1.Secret, error) {
+	return nil, nil //nolint:nilnil
 }
 
 func (s skrClientStub) DeleteSecret(_ context.Context) error {
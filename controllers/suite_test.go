@@ -29,6 +29,7 @@ import (
 	kcorev1 "k8s.io/api/core/v1"
 	kapierrors "k8s.io/apimachinery/pkg/api/errors"
 	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -43,6 +44,7 @@ import (
 
 	eamapiv1alpha1 "github.com/kyma-project/eventing-auth-manager/api/v1alpha1"
 	"github.com/kyma-project/eventing-auth-manager/controllers"
+	eamias "github.com/kyma-project/eventing-auth-manager/internal/ias"
 	"github.com/kyma-project/eventing-auth-manager/internal/skr"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -54,23 +56,28 @@ import (
 
 const (
 	defaultTimeout = 120 * time.Second
+	testInstanceID = "test-instance"
+	stubbedIasURL  = "https://stubbed-tenant.accounts.ondemand.com"
+	// testMaxConcurrentReconciles runs the controllers with multiple workers to cover concurrent reconciliations.
+	testMaxConcurrentReconciles = 2
 )
 
 var (
-	cfg                    *rest.Config
-	k8sClient              client.Client
-	ctx                    context.Context
-	cancel                 context.CancelFunc
-	targetClusterK8sCfg    string
-	targetClusterK8sClient client.Client
-	testEnv                *envtest.Environment
-	targetClusterTestEnv   *envtest.Environment
-	iasURL                 string
-	iasUsername            string
-	iasPassword            string
-	useExistingCluster     bool
-	kcpNs                  *kcorev1.Namespace
-	kymaNs                 *kcorev1.Namespace
+	cfg                      *rest.Config
+	k8sClient                client.Client
+	ctx                      context.Context
+	cancel                   context.CancelFunc
+	targetClusterK8sCfg      string
+	targetClusterK8sClient   client.Client
+	testEnv                  *envtest.Environment
+	targetClusterTestEnv     *envtest.Environment
+	iasURL                   string
+	iasUsername              string
+	iasPassword              string
+	useExistingCluster       bool
+	kcpNs                    *kcorev1.Namespace
+	kymaNs                   *kcorev1.Namespace
+	iasCredentialsReconciler *controllers.IasCredentialsReconciler
 )
 
 func TestAPIs(t *testing.T) {
@@ -84,6 +91,10 @@ var _ = BeforeSuite(func(specCtx SpecContext) {
 	ctx, cancel = context.WithCancel(context.TODO())
 
 	iasURL = os.Getenv("TEST_EVENTING_AUTH_IAS_URL")
+	if iasURL == "" {
+		// the stubbed IAS client doesn't use the URL, but the IAS credentials must pass the validation of the URL
+		iasURL = stubbedIasURL
+	}
 	iasUsername = os.Getenv("TEST_EVENTING_AUTH_IAS_USER")
 	iasPassword = os.Getenv("TEST_EVENTING_AUTH_IAS_PASSWORD")
 	useExistingCluster = os.Getenv("USE_EXISTING_CLUSTER") == "true"
@@ -145,10 +156,23 @@ var _ = BeforeSuite(func(specCtx SpecContext) {
 	// Since we are replacing in some test scenarios the original functions we need to keep them, so we are able to reset them after the tests.
 	storeOriginalsOfStubbedFunctions()
 
-	kymaReconciler := controllers.NewKymaReconciler(mgr.GetClient(), mgr.GetScheme())
+	kymaReconciler := controllers.NewKymaReconciler(mgr.GetClient(), mgr.GetScheme(), controllers.KymaReconcilerOptions{
+		MaxConcurrentReconciles: testMaxConcurrentReconciles,
+		PropagatedLabels:        controllers.DefaultKymaPropagatedLabels,
+		OptOutLabel:             controllers.DefaultKymaOptOutLabel,
+	})
 	Expect(kymaReconciler.SetupWithManager(mgr)).Should(Succeed())
 
-	eventingAuthReconciler := controllers.NewEventingAuthReconciler(mgr.GetClient(), mgr.GetScheme(), "GAID")
+	iasCredentialsReconciler = controllers.NewIasCredentialsReconciler(mgr.GetClient(), eamias.DefaultClientOptions(), types.NamespacedName{})
+	Expect(iasCredentialsReconciler.SetupWithManager(mgr)).Should(Succeed())
+
+	eventingAuthReconciler := controllers.NewEventingAuthReconciler(mgr.GetClient(), mgr.GetScheme(), iasCredentialsReconciler,
+		controllers.EventingAuthReconcilerOptions{
+			GlobalAccountID:         "GAID",
+			DuplicatePolicy:         eamias.DuplicatePolicyFail,
+			InstanceID:              testInstanceID,
+			MaxConcurrentReconciles: testMaxConcurrentReconciles,
+		})
 	Expect(eventingAuthReconciler.SetupWithManager(mgr)).Should(Succeed())
 
 	go func() {
@@ -160,12 +184,10 @@ var _ = BeforeSuite(func(specCtx SpecContext) {
 var _ = AfterSuite(func() {
 	cancel()
 	revertSkrNewClientStub()
-	revertReadCredentialsStub()
 	if !existIasCreds() {
 		revertIasNewClientStub()
-	} else {
-		deleteIasCredsSecret()
 	}
+	deleteIasCredsSecret()
 
 	By("Tearing down the test environment")
 	stopTestEnv(testEnv)
@@ -182,7 +204,7 @@ func stopTestEnv(env *envtest.Environment) {
 }
 
 func existIasCreds() bool {
-	return iasURL != "" && iasUsername != "" && iasPassword != ""
+	return iasURL != stubbedIasURL && iasUsername != "" && iasPassword != ""
 }
 
 func initTargetClusterConfig() (client.Client, error) {
@@ -0,0 +1,103 @@
+package audit
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/kyma-project/eventing-auth-manager/internal/redact"
+)
+
+// Operation is an operation in the lifecycle of the credentials of a managed runtime.
+type Operation string
+
+// Operations on the IAS applications and on the secrets with the credentials on the managed runtimes.
+const (
+	OperationCreateApplication  Operation = "create-application"
+	OperationRotateCredentials  Operation = "rotate-credentials"
+	OperationRevokeCredentials  Operation = "revoke-credentials"
+	OperationDeleteApplication  Operation = "delete-application"
+	OperationReleaseApplication Operation = "release-application"
+	OperationCreateSecret       Operation = "create-secret"
+	OperationUpdateSecret       Operation = "update-secret"
+	OperationDeleteSecret       Operation = "delete-secret"
+)
+
+// Outcome is the result of an audited operation.
+type Outcome string
+
+const (
+	OutcomeSuccess Outcome = "success"
+	OutcomeFailure Outcome = "failure"
+)
+
+// Record is an entry of the audit log. It must never contain secret material, which is why the error is only recorded as redacted
+// message.
+type Record struct {
+	Timestamp     time.Time `json:"timestamp"`
+	Operation     Operation `json:"operation"`
+	Outcome       Outcome   `json:"outcome"`
+	RuntimeID     string    `json:"runtimeId,omitempty"`
+	ApplicationID string    `json:"applicationId,omitempty"`
+	// Actor is the identity of the operator instance that performed the operation.
+	Actor string `json:"actor"`
+	Error string `json:"error,omitempty"`
+}
+
+// NewRecord creates a record whose outcome is derived from the error of the operation. Secret values in the error message are
+// masked.
+func NewRecord(operation Operation, actor, runtimeID, applicationID string, err error) Record {
+	record := Record{
+		Operation:     operation,
+		Outcome:       OutcomeSuccess,
+		RuntimeID:     runtimeID,
+		ApplicationID: applicationID,
+		Actor:         actor,
+	}
+	if err != nil {
+		record.Outcome = OutcomeFailure
+		record.Error = redact.String(err.Error())
+	}
+	return record
+}
+
+// Sink persists the audit records.
+type Sink interface {
+	Record(record Record)
+}
+
+// NopSink discards all records.
+type NopSink struct{}
+
+func (NopSink) Record(Record) {}
+
+// jsonLinesSink writes every record as a single line of JSON.
+type jsonLinesSink struct {
+	mu  sync.Mutex
+	w   io.Writer
+	now func() time.Time
+	// onError is called if a record can't be written, since the audited operation must not fail because of the audit log.
+	onError func(err error, record Record)
+}
+
+// NewJSONLinesSink returns a sink that writes the records as JSON lines to the writer. Records without timestamp are stamped with the
+// current time. Errors writing a record are passed to onError.
+func NewJSONLinesSink(w io.Writer, onError func(err error, record Record)) Sink {
+	return &jsonLinesSink{w: w, now: time.Now, onError: onError}
+}
+
+func (s *jsonLinesSink) Record(record Record) {
+	if record.Timestamp.IsZero() {
+		record.Timestamp = s.now().UTC()
+	}
+	line, err := json.Marshal(record)
+	if err == nil {
+		s.mu.Lock()
+		_, err = s.w.Write(append(line, '\n'))
+		s.mu.Unlock()
+	}
+	if err != nil && s.onError != nil {
+		s.onError(err, record)
+	}
+}
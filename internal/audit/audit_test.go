@@ -0,0 +1,111 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewRecord(t *testing.T) {
+	errDelete := errors.New("failed to delete application")                                  //nolint:goerr113 // used one time only in tests.
+	errWithSecret := errors.New(`failed to create secret: {"client_secret":"secret-value"}`) //nolint:goerr113 // used one time only in tests.
+	tests := []struct {
+		name       string
+		givenErr   error
+		wantRecord Record
+	}{
+		{
+			name: "should record success",
+			wantRecord: Record{
+				Operation:     OperationDeleteApplication,
+				Outcome:       OutcomeSuccess,
+				RuntimeID:     "runtime-id",
+				ApplicationID: "app-id",
+				Actor:         "eventing-auth-manager/default",
+			},
+		},
+		{
+			name:     "should record failure with error message",
+			givenErr: errDelete,
+			wantRecord: Record{
+				Operation:     OperationDeleteApplication,
+				Outcome:       OutcomeFailure,
+				RuntimeID:     "runtime-id",
+				ApplicationID: "app-id",
+				Actor:         "eventing-auth-manager/default",
+				Error:         "failed to delete application",
+			},
+		},
+		{
+			name:     "should record failure with redacted error message",
+			givenErr: errWithSecret,
+			wantRecord: Record{
+				Operation:     OperationDeleteApplication,
+				Outcome:       OutcomeFailure,
+				RuntimeID:     "runtime-id",
+				ApplicationID: "app-id",
+				Actor:         "eventing-auth-manager/default",
+				Error:         `failed to create secret: {"client_secret":"[REDACTED]"}`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			record := NewRecord(OperationDeleteApplication, "eventing-auth-manager/default", "runtime-id", "app-id", tt.givenErr)
+
+			// then
+			require.Equal(t, tt.wantRecord, record)
+		})
+	}
+}
+
+func Test_jsonLinesSink(t *testing.T) {
+	// given
+	var buf bytes.Buffer
+	sink := NewJSONLinesSink(&buf, nil)
+	sink.(*jsonLinesSink).now = func() time.Time {
+		return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	}
+
+	// when
+	sink.Record(NewRecord(OperationCreateApplication, "eventing-auth-manager/default", "runtime-id", "app-id", nil))
+	sink.Record(NewRecord(OperationCreateSecret, "eventing-auth-manager/default", "runtime-id", "app-id", errors.New("conflict"))) //nolint:goerr113,lll // used one time only in tests.
+
+	// then
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 2)
+	require.JSONEq(t, `{"timestamp":"2024-01-02T03:04:05Z","operation":"create-application","outcome":"success",`+
+		`"runtimeId":"runtime-id","applicationId":"app-id","actor":"eventing-auth-manager/default"}`, lines[0])
+	var record Record
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &record))
+	require.Equal(t, OutcomeFailure, record.Outcome)
+	require.Equal(t, "conflict", record.Error)
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full") //nolint:goerr113 // used one time only in tests.
+}
+
+func Test_jsonLinesSink_WriteError(t *testing.T) {
+	// given
+	var failedRecord *Record
+	sink := NewJSONLinesSink(failingWriter{}, func(err error, record Record) {
+		require.EqualError(t, err, "disk full")
+		failedRecord = &record
+	})
+
+	// when
+	sink.Record(NewRecord(OperationDeleteSecret, "eventing-auth-manager/default", "runtime-id", "", nil))
+
+	// then
+	require.NotNil(t, failedRecord)
+	require.Equal(t, OperationDeleteSecret, failedRecord.Operation)
+}
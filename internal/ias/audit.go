@@ -0,0 +1,23 @@
+package ias
+
+import (
+	"k8s.io/utils/ptr"
+
+	"github.com/kyma-project/eventing-auth-manager/internal/audit"
+)
+
+// audit records an operation on the application of the given runtime. The record never contains the credentials of the application.
+func (c *client) audit(operation audit.Operation, runtimeID, applicationID string, err error) {
+	if c.auditSink == nil {
+		return
+	}
+	c.auditSink.Record(audit.NewRecord(operation, c.operatorIdentity, runtimeID, applicationID, err))
+}
+
+// modifiedOnBehalfOf returns the value of the Modified-on-behalf-of header sent when applications are created or modified.
+func (c *client) modifiedOnBehalfOf() *string {
+	if c.operatorIdentity == "" {
+		return nil
+	}
+	return ptr.To(c.operatorIdentity)
+}
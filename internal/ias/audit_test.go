@@ -0,0 +1,131 @@
+package ias
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/kyma-project/eventing-auth-manager/internal/audit"
+	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api"
+	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api/mocks"
+)
+
+type recordingSink struct {
+	records []audit.Record
+}
+
+func (s *recordingSink) Record(record audit.Record) {
+	s.records = append(s.records, record)
+}
+
+func Test_CreateApplication_Audit(t *testing.T) {
+	// given
+	existingAppID := uuid.MustParse("90764f89-f041-4ccf-8da9-7a7c2d60d7fc")
+	apiMock := &mocks.ClientWithResponsesInterface{}
+	mockGetAllApplicationsWithResponseStatusOk(apiMock, existingAppID)
+	mockDeleteApplicationWithResponseStatusOk(apiMock, existingAppID)
+	apiMock.On("CreateApplicationWithResponse", mock.Anything,
+		&api.CreateApplicationParams{ModifiedOnBehalfOf: ptr.To("eventing-auth-manager/test-instance")}, newTestIasApplication()).
+		Return(&api.CreateApplicationResponse{
+			HTTPResponse: &http.Response{StatusCode: http.StatusInternalServerError},
+		}, nil)
+	sink := &recordingSink{}
+	client := client{
+		api:              apiMock,
+		operatorIdentity: OperatorIdentity(testOwner.InstanceID),
+		auditSink:        sink,
+	}
+
+	// when
+	_, err := client.CreateApplication(context.TODO(), "Test-App-Name", ApplicationConfig{GlobalAccountID: "GAID", Owner: testOwner, CredentialType: CredentialTypeSecret})
+
+	// then
+	require.EqualError(t, err, errCreateApplication.Error())
+	require.Equal(t, []audit.Record{
+		{
+			Operation:     audit.OperationDeleteApplication,
+			Outcome:       audit.OutcomeSuccess,
+			RuntimeID:     "Test-App-Name",
+			ApplicationID: existingAppID.String(),
+			Actor:         "eventing-auth-manager/test-instance",
+		},
+		{
+			Operation: audit.OperationCreateApplication,
+			Outcome:   audit.OutcomeFailure,
+			RuntimeID: "Test-App-Name",
+			Actor:     "eventing-auth-manager/test-instance",
+			Error:     errCreateApplication.Error(),
+		},
+	}, sink.records)
+	apiMock.AssertExpectations(t)
+}
+
+func Test_CreateApplication_AuditCreatedApplicationOnFailure(t *testing.T) {
+	// given
+	appID := uuid.MustParse("90764f89-f041-4ccf-8da9-7a7c2d60d7fc")
+	apiMock := &mocks.ClientWithResponsesInterface{}
+	mockGetAllApplicationsWithResponseStatusOkEmptyResponse(apiMock)
+	mockCreateApplicationWithResponseStatusCreated(apiMock, appID.String())
+	mockCreateAPISecretWithResponseStatusInternalServerError(apiMock)
+	sink := &recordingSink{}
+	client := client{
+		api:              apiMock,
+		operatorIdentity: OperatorIdentity(testOwner.InstanceID),
+		auditSink:        sink,
+	}
+
+	// when
+	_, err := client.CreateApplication(context.TODO(), "Test-App-Name", ApplicationConfig{GlobalAccountID: "GAID", Owner: testOwner, CredentialType: CredentialTypeSecret})
+
+	// then
+	require.EqualError(t, err, errCreateAPISecret.Error())
+	require.Equal(t, []audit.Record{
+		{
+			Operation:     audit.OperationCreateApplication,
+			Outcome:       audit.OutcomeFailure,
+			RuntimeID:     "Test-App-Name",
+			ApplicationID: appID.String(),
+			Actor:         "eventing-auth-manager/test-instance",
+			Error:         errCreateAPISecret.Error(),
+		},
+	}, sink.records)
+	apiMock.AssertExpectations(t)
+}
+
+func Test_DeleteApplication_Audit(t *testing.T) {
+	// given
+	appID := uuid.MustParse("90764f89-f041-4ccf-8da9-7a7c2d60d7fc")
+	apiMock := &mocks.ClientWithResponsesInterface{}
+	mockDeleteApplicationWithResponseStatusInternalServerError(apiMock)
+	sink := &recordingSink{}
+	client := client{
+		api:              apiMock,
+		operatorIdentity: OperatorIdentity(testOwner.InstanceID),
+		auditSink:        sink,
+	}
+
+	// when
+	err := client.DeleteApplicationByID(context.TODO(), appID.String())
+
+	// then
+	require.EqualError(t, err, errDeleteApplication.Error())
+	require.Equal(t, []audit.Record{
+		{
+			Operation:     audit.OperationDeleteApplication,
+			Outcome:       audit.OutcomeFailure,
+			ApplicationID: appID.String(),
+			Actor:         "eventing-auth-manager/test-instance",
+			Error:         errDeleteApplication.Error(),
+		},
+	}, sink.records)
+}
+
+func Test_OperatorIdentity(t *testing.T) {
+	require.Equal(t, "eventing-auth-manager/test-instance", OperatorIdentity("test-instance"))
+	require.Equal(t, "eventing-auth-manager", OperatorIdentity(""))
+}
@@ -0,0 +1,101 @@
+package ias
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+	kcorev1 "k8s.io/api/core/v1"
+)
+
+const (
+	certificateKeySize  = 2048
+	certificateValidity = 365 * 24 * time.Hour
+	serialNumberBits    = 128
+)
+
+type clientCertificate struct {
+	// certificatePEM is the PEM encoded certificate that is delivered to the managed runtime.
+	certificatePEM []byte
+	// privateKeyPEM is the PEM encoded private key that is delivered to the managed runtime.
+	privateKeyPEM []byte
+	// base64DER is the base64 encoded DER certificate that is registered on the IAS application.
+	base64DER string
+	dn        string
+}
+
+// newClientCertificate generates a new key pair and a self-signed certificate with the given common name.
+func newClientCertificate(commonName string) (clientCertificate, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, certificateKeySize)
+	if err != nil {
+		return clientCertificate{}, errors.Wrap(err, "failed to generate private key")
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), serialNumberBits))
+	if err != nil {
+		return clientCertificate{}, errors.Wrap(err, "failed to generate certificate serial number")
+	}
+
+	subject := pkix.Name{CommonName: commonName}
+	now := time.Now()
+	template := x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      subject,
+		NotBefore:    now,
+		NotAfter:     now.Add(certificateValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		return clientCertificate{}, errors.Wrap(err, "failed to create certificate")
+	}
+
+	return clientCertificate{
+		certificatePEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		privateKeyPEM:  pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)}),
+		base64DER:      base64.StdEncoding.EncodeToString(der),
+		dn:             subject.String(),
+	}, nil
+}
+
+// CertificateRenewBefore is the remaining validity of a client certificate below which the credentials are renewed.
+const CertificateRenewBefore = 30 * 24 * time.Hour
+
+// RenewalReason explains why the credentials in the application secret on the managed runtime must be renewed.
+type RenewalReason string
+
+const (
+	// RenewalReasonCredentialTypeChanged means that the secret holds credentials of another credential type than desired.
+	RenewalReasonCredentialTypeChanged RenewalReason = "CredentialTypeChanged"
+	// RenewalReasonCertificateExpiring means that the client certificate in the secret expires within CertificateRenewBefore or can't
+	// be parsed.
+	RenewalReasonCertificateExpiring RenewalReason = "CertificateExpiring"
+)
+
+// CredentialsRenewalReason returns why the credentials in the application secret must be renewed to match the desired credential type,
+// or an empty reason if the credentials are up to date.
+func CredentialsRenewalReason(secret kcorev1.Secret, credentialType CredentialType, now time.Time) RenewalReason {
+	certificatePEM, hasCertificate := secret.Data[kcorev1.TLSCertKey]
+	if hasCertificate != (credentialType == CredentialTypeCertificate) {
+		return RenewalReasonCredentialTypeChanged
+	}
+	if !hasCertificate {
+		return ""
+	}
+	block, _ := pem.Decode(certificatePEM)
+	if block == nil {
+		return RenewalReasonCertificateExpiring
+	}
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil || certificate.NotAfter.Sub(now) < CertificateRenewBefore {
+		return RenewalReasonCertificateExpiring
+	}
+	return ""
+}
@@ -0,0 +1,101 @@
+package ias
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	kcorev1 "k8s.io/api/core/v1"
+)
+
+func Test_newClientCertificate(t *testing.T) {
+	// when
+	cert, err := newClientCertificate("Test-App-Name")
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, "CN=Test-App-Name", cert.dn)
+
+	keyPair, err := tls.X509KeyPair(cert.certificatePEM, cert.privateKeyPEM)
+	require.NoError(t, err)
+	require.Len(t, keyPair.Certificate, 1)
+
+	der, err := base64.StdEncoding.DecodeString(cert.base64DER)
+	require.NoError(t, err)
+	require.Equal(t, keyPair.Certificate[0], der)
+
+	parsed, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	require.Equal(t, "Test-App-Name", parsed.Subject.CommonName)
+	require.Contains(t, parsed.ExtKeyUsage, x509.ExtKeyUsageClientAuth)
+}
+
+func Test_CredentialsRenewalReason(t *testing.T) {
+	cert, err := newClientCertificate("Test-App-Name")
+	require.NoError(t, err)
+	now := time.Now()
+	certificateSecret := NewApplicationWithCertificate("app-id", "client-id", cert.certificatePEM, cert.privateKeyPEM, "", "").
+		ToSecret("name", "namespace")
+	clientSecret := NewApplication("app-id", "client-id", "client-secret", "", "").ToSecret("name", "namespace")
+
+	tests := []struct {
+		name              string
+		givenSecret       kcorev1.Secret
+		givenType         CredentialType
+		givenNow          time.Time
+		wantRenewalReason RenewalReason
+	}{
+		{
+			name:        "should not renew client secret",
+			givenSecret: clientSecret,
+			givenType:   CredentialTypeSecret,
+			givenNow:    now,
+		},
+		{
+			name:        "should not renew valid certificate",
+			givenSecret: certificateSecret,
+			givenType:   CredentialTypeCertificate,
+			givenNow:    now,
+		},
+		{
+			name:              "should renew certificate that expires soon",
+			givenSecret:       certificateSecret,
+			givenType:         CredentialTypeCertificate,
+			givenNow:          now.Add(certificateValidity - CertificateRenewBefore + time.Hour),
+			wantRenewalReason: RenewalReasonCertificateExpiring,
+		},
+		{
+			name:              "should renew invalid certificate",
+			givenSecret:       kcorev1.Secret{Data: map[string][]byte{kcorev1.TLSCertKey: []byte("invalid")}},
+			givenType:         CredentialTypeCertificate,
+			givenNow:          now,
+			wantRenewalReason: RenewalReasonCertificateExpiring,
+		},
+		{
+			name:              "should renew client secret when certificate is desired",
+			givenSecret:       clientSecret,
+			givenType:         CredentialTypeCertificate,
+			givenNow:          now,
+			wantRenewalReason: RenewalReasonCredentialTypeChanged,
+		},
+		{
+			name:              "should renew certificate when client secret is desired",
+			givenSecret:       certificateSecret,
+			givenType:         CredentialTypeSecret,
+			givenNow:          now,
+			wantRenewalReason: RenewalReasonCredentialTypeChanged,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			reason := CredentialsRenewalReason(tt.givenSecret, tt.givenType, tt.givenNow)
+
+			// then
+			require.Equal(t, tt.wantRenewalReason, reason)
+		})
+	}
+}
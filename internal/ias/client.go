@@ -1,20 +1,27 @@
 package ias
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"reflect"
 	"strings"
-	"time"
+	"sync"
 
 	"github.com/deepmap/oapi-codegen/pkg/securityprovider"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"k8s.io/utils/ptr"
-	kcontrollerruntime "sigs.k8s.io/controller-runtime"
 
+	"github.com/kyma-project/eventing-auth-manager/internal/audit"
 	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api"
 	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/oidc"
+	"github.com/kyma-project/eventing-auth-manager/internal/logging"
+	eammetrics "github.com/kyma-project/eventing-auth-manager/internal/metrics"
+	"github.com/kyma-project/eventing-auth-manager/internal/redact"
+	"github.com/kyma-project/eventing-auth-manager/internal/tracing"
 )
 
 var (
@@ -26,41 +33,92 @@ var (
 	errFetchTokenURL                           = errors.New("failed to fetch token url")
 	errFetchJWKSURI                            = errors.New("failed to fetch jwks uri")
 	errDeleteApplication                       = errors.New("failed to delete application")
+	errRetrieveApplication                     = errors.New("failed to retrieve application")
+	errPatchApplication                        = errors.New("failed to patch application")
+	errListApplications                        = errors.New("failed to list applications")
 )
 
 type Client interface {
-	CreateApplication(ctx context.Context, name, globalAccountID string) (Application, error)
-	DeleteApplication(ctx context.Context, name string) error
+	CreateApplication(ctx context.Context, name string, config ApplicationConfig) (Application, error)
+	UpdateApplication(ctx context.Context, name string, config ApplicationConfig) (bool, error)
+	DeleteApplication(ctx context.Context, name string, owner Ownership) error
+	DeleteApplicationByID(ctx context.Context, id string) error
+	ReleaseApplication(ctx context.Context, name string, owner Ownership) error
+	ListApplications(ctx context.Context, filter Filter) ([]ApplicationInfo, error)
+	GetApplicationByID(ctx context.Context, id string) (*ApplicationInfo, error)
+	GetApplicationByClientID(ctx context.Context, clientID string) (*ApplicationInfo, error)
+	RegenerateCredentials(ctx context.Context, name string, config ApplicationConfig) (Application, error)
+	RevokeCredentials(ctx context.Context, name string, app Application) error
+	ResolveDuplicateApplications(ctx context.Context, name string, policy DuplicatePolicy, owner Ownership) (string, error)
 	GetCredentials() *Credentials
+	Ping(ctx context.Context) error
 }
 
-var NewClient = func(iasTenantUrl, user, password string) (Client, error) { //nolint:gochecknoglobals // For mocking purposes.
+// ClientOptions contains the configuration of the IAS client.
+type ClientOptions struct {
+	// RateLimitQPS is the number of requests per second that are sent to IAS. Values below or equal to 0 disable the rate limit.
+	RateLimitQPS float64
+	// RateLimitBurst is the number of requests that can be sent at once before the rate limit applies. Values below 1 allow one request.
+	RateLimitBurst int
+	// Transport configures the HTTP transport of the requests to IAS.
+	Transport TransportOptions
+	// OperatorIdentity is sent as the user on whose behalf applications are created and modified, and recorded as actor in the audit log.
+	OperatorIdentity string
+	// AuditSink receives the records of the credential lifecycle operations. If nil, no records are written.
+	AuditSink audit.Sink
+}
+
+// DefaultClientOptions returns the client options with the default rate limit and transport.
+func DefaultClientOptions() ClientOptions {
+	return ClientOptions{
+		RateLimitQPS:     DefaultRateLimitQPS,
+		RateLimitBurst:   DefaultRateLimitBurst,
+		Transport:        DefaultTransportOptions(),
+		OperatorIdentity: ManagedBy,
+	}
+}
+
+// NewClient creates a client for the given IAS tenant. The requests to the Applications API and to the OIDC configuration share one HTTP
+// transport and one rate limiter, which are configured with the options.
+var NewClient = func(iasTenantUrl, user, password string, options ClientOptions) (Client, error) { //nolint:gochecknoglobals // For mocking purposes.
 	basicAuthProvider, err := securityprovider.NewSecurityProviderBasicAuth(user, password)
 	if err != nil {
 		return nil, err
 	}
 
-	applicationsEndpointURL := fmt.Sprintf("%s/Applications/v1/", iasTenantUrl)
-	apiClient, err := api.NewClientWithResponses(applicationsEndpointURL, api.WithRequestEditorFn(basicAuthProvider.Intercept))
+	transport, err := options.Transport.newTransport()
 	if err != nil {
 		return nil, err
 	}
-
-	const timeout = time.Second * 5
-	oidcHTTPClient := &http.Client{
-		Timeout: timeout,
+	// The rate limiter is waited for before the requests are passed to the HTTP client, so that the request timeout only covers the
+	// request itself.
+	limiter := options.newRateLimiter()
+	httpClient := &http.Client{
+		Timeout:   options.Transport.RequestTimeout,
+		Transport: transport,
+	}
+	applicationsEndpointURL := fmt.Sprintf("%s/Applications/v1/", iasTenantUrl)
+	apiClient, err := api.NewClientWithResponses(applicationsEndpointURL,
+		api.WithHTTPClient(newRateLimitedDoer(httpClient, limiter, eammetrics.IasAPIApplications)),
+		api.WithRequestEditorFn(basicAuthProvider.Intercept), api.WithRequestEditorFn(tracing.InjectTraceContext))
+	if err != nil {
+		return nil, err
 	}
 
-	return &client{
-		api:         apiClient,
-		oidcClient:  oidc.NewOidcClient(oidcHTTPClient, iasTenantUrl),
-		credentials: &Credentials{URL: iasTenantUrl, Username: user, Password: password},
-	}, nil
+	return newTracingClient(&client{
+		api:              apiClient,
+		oidcClient:       oidc.NewOidcClient(newRateLimitedDoer(httpClient, limiter, eammetrics.IasAPIOIDC), iasTenantUrl),
+		credentials:      &Credentials{URL: iasTenantUrl, Username: user, Password: password},
+		operatorIdentity: options.OperatorIdentity,
+		auditSink:        options.AuditSink,
+	}), nil
 }
 
 type client struct {
 	api        api.ClientWithResponsesInterface
 	oidcClient oidc.Client
+	// oidcMu guards the cached token URL and jwks URI, since the client is shared by concurrent reconciliations.
+	oidcMu sync.Mutex
 	// The token URL of the IAS client. Since this URL should only change when the tenant changes and this will lead to the initialization of
 	// a new client, we can cache the URL to avoid an additional request at each application creation.
 	tokenURL *string
@@ -68,6 +126,9 @@ type client struct {
 	// a new client, we can cache the URI to avoid an additional request at each application creation.
 	jwksURI     *string
 	credentials *Credentials
+	// operatorIdentity is sent in the Modified-on-behalf-of header and recorded as actor in the audit log.
+	operatorIdentity string
+	auditSink        audit.Sink
 }
 
 func (c *client) GetCredentials() *Credentials {
@@ -78,9 +139,15 @@ func (c *client) GetCredentials() *Credentials {
 }
 
 // CreateApplication creates an application in IAS. This function is not idempotent, because if an application with the specified
-// name already exists, it will be deleted and recreated.
-func (c *client) CreateApplication(ctx context.Context, name, globalAccountID string) (Application, error) {
-	existingApp, err := c.getApplicationByName(ctx, name)
+// name and ownership already exists, it will be deleted and recreated. The application is rendered from the application template of
+// the config and the ownership of the config is recorded in the description of the application. Depending on the credential type,
+// either a client secret is created or a client certificate is registered on the application.
+func (c *client) CreateApplication(ctx context.Context, name string, config ApplicationConfig) (app Application, err error) {
+	// The ID of the created application is recorded even if a later step fails, since the application then exists in IAS.
+	var createdAppID string
+	defer func() { c.audit(audit.OperationCreateApplication, name, createdAppID, err) }()
+
+	existingApp, err := c.getApplicationByName(ctx, name, config.Owner)
 	if err != nil {
 		return Application{}, err
 	}
@@ -89,26 +156,45 @@ func (c *client) CreateApplication(ctx context.Context, name, globalAccountID st
 	// a new one, otherwise we would have to check where the application creation failed and continue at this point.
 	if existingApp != nil {
 		res, err := c.api.DeleteApplicationWithResponse(ctx, *existingApp.Id)
+		if err == nil && res.StatusCode() != http.StatusOK {
+			logging.FromContext(ctx, logging.ComponentIAS).Error(err, "Failed to delete existing application", "id", *existingApp.Id, "statusCode", res.StatusCode(), "body", redact.Body(res.Body))
+			err = classifyStatusCode(errDeleteExistingApplicationBeforeCreation, res.StatusCode())
+		}
+		c.audit(audit.OperationDeleteApplication, name, existingApp.Id.String(), err)
 		if err != nil {
 			return Application{}, err
 		}
-		if res.StatusCode() != http.StatusOK {
-			kcontrollerruntime.Log.Error(err, "Failed to delete existing application", "id", *existingApp.Id, "statusCode", res.StatusCode())
-			return Application{}, errDeleteExistingApplicationBeforeCreation
+	}
+
+	var certificate *clientCertificate
+	if config.CredentialType == CredentialTypeCertificate {
+		cert, err := newClientCertificate(name)
+		if err != nil {
+			return Application{}, err
 		}
+		certificate = &cert
 	}
 
-	appID, err := c.createNewApplication(ctx, name, globalAccountID)
+	appID, err := c.createNewApplication(ctx, name, config, certificate)
 	if err != nil {
 		return Application{}, err
 	}
-	kcontrollerruntime.Log.Info("Created application", "name", name, "id", appID)
+	createdAppID = appID.String()
+	logging.FromContext(ctx, logging.ComponentIAS).Info("Created application", "name", name, "id", appID)
 
-	clientSecret, err := c.createSecret(ctx, appID)
-	if err != nil {
-		return Application{}, err
+	var secret *api.ApiSecretResponse
+	if certificate == nil {
+		secret, err = c.createAPISecret(ctx, appID)
+		if err != nil {
+			return Application{}, err
+		}
 	}
 
+	return c.newApplicationWithCredentials(ctx, appID, secret, certificate)
+}
+
+// newApplicationWithCredentials returns the application with the given ID and the given API secret or client certificate.
+func (c *client) newApplicationWithCredentials(ctx context.Context, appID uuid.UUID, secret *api.ApiSecretResponse, certificate *clientCertificate) (Application, error) {
 	clientID, err := c.getClientID(ctx, appID)
 	if err != nil {
 		return Application{}, err
@@ -126,17 +212,27 @@ func (c *client) CreateApplication(ctx context.Context, name, globalAccountID st
 		return Application{}, err
 	}
 
-	return NewApplication(appID.String(), *clientID, *clientSecret, *tokenURL, *jwksURI), nil
+	if certificate != nil {
+		app := NewApplicationWithCertificate(appID.String(), *clientID, certificate.certificatePEM, certificate.privateKeyPEM, *tokenURL, *jwksURI)
+		app.certificateBase64DER = certificate.base64DER
+		return app, nil
+	}
+	app := NewApplication(appID.String(), *clientID, ptr.Deref(secret.Secret, ""), *tokenURL, *jwksURI)
+	app.secretHint = ptr.Deref(secret.Hint, "")
+	return app, nil
 }
 
 func (c *client) GetTokenURL(ctx context.Context) (*string, error) {
+	c.oidcMu.Lock()
+	defer c.oidcMu.Unlock()
+
 	if c.tokenURL == nil {
 		tokenEndpoint, err := c.oidcClient.GetTokenEndpoint(ctx)
 		if err != nil {
-			return nil, err
+			return nil, classifyOIDCDiscovery(err)
 		}
 		if tokenEndpoint == nil {
-			return nil, errFetchTokenURL
+			return nil, classifyOIDCDiscovery(errFetchTokenURL)
 		}
 
 		c.tokenURL = tokenEndpoint
@@ -146,13 +242,16 @@ func (c *client) GetTokenURL(ctx context.Context) (*string, error) {
 }
 
 func (c *client) GetJWKSURI(ctx context.Context) (*string, error) {
+	c.oidcMu.Lock()
+	defer c.oidcMu.Unlock()
+
 	if c.jwksURI == nil {
 		jwksURI, err := c.oidcClient.GetJWKSURI(ctx)
 		if err != nil {
-			return nil, err
+			return nil, classifyOIDCDiscovery(err)
 		}
 		if jwksURI == nil {
-			return nil, errFetchJWKSURI
+			return nil, classifyOIDCDiscovery(errFetchJWKSURI)
 		}
 
 		c.jwksURI = jwksURI
@@ -161,78 +260,259 @@ func (c *client) GetJWKSURI(ctx context.Context) (*string, error) {
 	return c.jwksURI, nil
 }
 
-// DeleteApplication deletes an application in IAS. If the application does not exist, this function does nothing.
-func (c *client) DeleteApplication(ctx context.Context, name string) error {
-	existingApp, err := c.getApplicationByName(ctx, name)
+// UpdateApplication updates the settings of an existing application in IAS that are managed by the application template of the config,
+// if they differ from the desired settings. It returns true if the application was patched. If the application does not exist, this
+// function does nothing. Applications without matching ownership are not updated.
+func (c *client) UpdateApplication(ctx context.Context, name string, config ApplicationConfig) (bool, error) {
+	existingApp, err := c.getApplicationByName(ctx, name, config.Owner)
+	if err != nil {
+		return false, err
+	}
+	if existingApp == nil {
+		return false, nil
+	}
+
+	actualApp, err := c.getApplication(ctx, *existingApp.Id)
+	if err != nil {
+		return false, err
+	}
+
+	desiredApp, err := newIasApplication(name, config)
+	if err != nil {
+		return false, err
+	}
+	patch, err := newApplicationPatch(desiredApp, *actualApp)
+	if err != nil {
+		return false, err
+	}
+	if len(patch.Operations) == 0 {
+		return false, nil
+	}
+
+	if err := c.patchApplication(ctx, *existingApp.Id, patch); err != nil {
+		return false, err
+	}
+	logging.FromContext(ctx, logging.ComponentIAS).Info("Patched application", "name", name, "id", *existingApp.Id, "operations", len(patch.Operations))
+	return true, nil
+}
+
+// ReleaseApplication marks the recorded ownership of an existing application in IAS as released, so that the application is kept
+// when the EventingAuth CR is deleted with the Orphan deletion policy. A released application is never collected as orphan and can be
+// adopted by another operator instance managing the same runtime. If the application does not exist or doesn't have matching
+// ownership, this function does nothing.
+func (c *client) ReleaseApplication(ctx context.Context, name string, owner Ownership) (err error) {
+	existingApp, err := c.getApplicationByName(ctx, name, owner)
 	if err != nil {
 		return err
 	}
 	if existingApp == nil {
 		return nil
 	}
+	defer func() { c.audit(audit.OperationReleaseApplication, name, existingApp.Id.String(), err) }()
 
-	return c.deleteApplication(ctx, *existingApp.Id)
+	description := released(existingApp.Description)
+	if reflect.DeepEqual(description, existingApp.Description) {
+		return nil
+	}
+	// the description was changed, so it contains the recorded ownership and exists
+	patch := applicationPatch{Operations: []patchOperation{{Op: api.Replace, Path: "/description", Value: *description}}}
+	if err := c.patchApplication(ctx, *existingApp.Id, patch); err != nil {
+		return err
+	}
+	logging.FromContext(ctx, logging.ComponentIAS).Info("Released application", "name", name, "id", *existingApp.Id)
+	return nil
 }
 
-func (c *client) getApplicationByName(ctx context.Context, name string) (*api.ApplicationResponse, error) {
-	appsFilter := fmt.Sprintf("name eq %s", name)
-	res, err := c.api.GetAllApplicationsWithResponse(ctx, &api.GetAllApplicationsParams{Filter: &appsFilter})
+// patchApplication applies the patch operations to the application with the given ID.
+// getApplication returns all attributes of the existing application with the given ID.
+func (c *client) getApplication(ctx context.Context, id uuid.UUID) (*api.ApplicationResponse, error) {
+	res, err := c.api.GetApplicationWithResponse(ctx, id, &api.GetApplicationParams{})
 	if err != nil {
 		return nil, err
 	}
+	if res.StatusCode() != http.StatusOK {
+		logging.FromContext(ctx, logging.ComponentIAS).Error(err, "Failed to retrieve application", "id", id, "statusCode", res.StatusCode(), "body", redact.Body(res.Body))
+		return nil, classifyStatusCode(errRetrieveApplication, res.StatusCode())
+	}
+	return res.JSON200, nil
+}
+
+func (c *client) patchApplication(ctx context.Context, id uuid.UUID, patch applicationPatch) error {
+	body, err := json.Marshal(patch)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal application patch")
+	}
+	res, err := c.api.PatchApplicationWithBodyWithResponse(ctx, id,
+		&api.PatchApplicationParams{ModifiedOnBehalfOf: c.modifiedOnBehalfOf()}, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if res.StatusCode() != http.StatusOK && res.StatusCode() != http.StatusNoContent {
+		logging.FromContext(ctx, logging.ComponentIAS).Error(err, "Failed to patch application", "id", id, "statusCode", res.StatusCode(), "body", redact.Body(res.Body))
+		return classifyStatusCode(errPatchApplication, res.StatusCode())
+	}
+	return nil
+}
 
-	// This is not documented in the API, but the actual API returned 404 if no applications were found.
+// DeleteApplication deletes an application in IAS. If the application does not exist or doesn't have matching ownership, this
+// function does nothing.
+func (c *client) DeleteApplication(ctx context.Context, name string, owner Ownership) error {
+	existingApp, err := c.getApplicationByName(ctx, name, owner)
+	if err != nil {
+		return err
+	}
+	if existingApp == nil {
+		return nil
+	}
+
+	return c.deleteApplication(ctx, *existingApp.Id, name)
+}
+
+// DeleteApplicationByID deletes the application with the given ID in IAS. If the application does not exist, this function does nothing.
+func (c *client) DeleteApplicationByID(ctx context.Context, id string) error {
+	appID, err := uuid.Parse(id)
+	if err != nil {
+		return errors.Wrap(err, "invalid application ID")
+	}
+	// The runtime of an application deleted by ID is not known.
+	return c.deleteApplication(ctx, appID, "")
+}
+
+// ListApplications returns all applications of the IAS tenant matching the filter. An empty filter matches all applications.
+// The applications are fetched page by page by following the cursor of each page.
+func (c *client) ListApplications(ctx context.Context, filter Filter) ([]ApplicationInfo, error) {
+	apps, err := listApplications(ctx, c.api, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]ApplicationInfo, 0, len(apps))
+	for _, app := range apps {
+		infos = append(infos, toApplicationInfo(app))
+	}
+	return infos, nil
+}
+
+// GetApplicationByID returns the application with the given ID or nil if the application does not exist.
+func (c *client) GetApplicationByID(ctx context.Context, id string) (*ApplicationInfo, error) {
+	appID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid application ID")
+	}
+
+	res, err := c.api.GetApplicationWithResponse(ctx, appID, &api.GetApplicationParams{})
+	if err != nil {
+		return nil, err
+	}
 	if res.StatusCode() == http.StatusNotFound {
 		return nil, nil //nolint:nilnil
 	}
-
 	if res.StatusCode() != http.StatusOK {
-		kcontrollerruntime.Log.Error(err, "Failed to fetch existing applications filtered by name", "name", name, "statusCode", res.StatusCode())
-		return nil, errFetchExistingApplications
-	}
-
-	if res.JSON200.Applications != nil {
-		switch len(*res.JSON200.Applications) {
-		// Since the handling of the 404 status is not documented, we also handle the case where no more applications are found,
-		// because we do not know what the expected behavior should be.
-		case 0:
-			return nil, nil //nolint:nilnil
-		case 1:
-			return &(*res.JSON200.Applications)[0], nil
-		default:
-			return nil, errors.Errorf("found multiple applications with the same name %s", name)
+		logging.FromContext(ctx, logging.ComponentIAS).Error(err, "Failed to retrieve application", "id", id, "statusCode", res.StatusCode(), "body", redact.Body(res.Body))
+		return nil, classifyStatusCode(errRetrieveApplication, res.StatusCode())
+	}
+
+	info := toApplicationInfo(*res.JSON200)
+	return &info, nil
+}
+
+// GetApplicationByClientID returns the application with the given client ID or nil if no application has this client ID. This
+// allows to resolve a client ID, e.g. found in a Secret of a managed runtime, back to its application.
+func (c *client) GetApplicationByClientID(ctx context.Context, clientID string) (*ApplicationInfo, error) {
+	filter, err := FilterByClientID(clientID)
+	if err != nil {
+		return nil, err
+	}
+	apps, err := c.ListApplications(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(apps) {
+	case 0:
+		return nil, nil //nolint:nilnil
+	case 1:
+		return &apps[0], nil
+	default:
+		return nil, errors.Errorf("found multiple applications with the same client ID %s", clientID)
+	}
+}
+
+// getApplicationByName returns the application with the given name and matching ownership. Applications of other owners with the
+// same name are ignored, so that they are never modified or deleted.
+func (c *client) getApplicationByName(ctx context.Context, name string, owner Ownership) (*api.ApplicationResponse, error) {
+	apps, err := c.getOwnedApplicationsByName(ctx, name, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(apps) {
+	case 0:
+		return nil, nil //nolint:nilnil
+	case 1:
+		return &apps[0], nil
+	default:
+		return nil, newDuplicateApplicationsError(name, apps)
+	}
+}
+
+func (c *client) getOwnedApplicationsByName(ctx context.Context, name string, owner Ownership) ([]api.ApplicationResponse, error) {
+	filter, err := FilterByName(name)
+	if err != nil {
+		return nil, err
+	}
+	apps, err := listApplications(ctx, c.api, filter)
+	if err != nil {
+		if errors.Is(err, errListApplications) {
+			logging.FromContext(ctx, logging.ComponentIAS).Error(err, "Failed to fetch existing applications filtered by name", "name", name)
+			return nil, &classifiedError{err: errFetchExistingApplications, cause: err}
 		}
+		return nil, err
 	}
-	return nil, nil //nolint:nilnil
+
+	owned := filterOwned(apps, owner)
+	if len(owned) < len(apps) {
+		logging.FromContext(ctx, logging.ComponentIAS).Info("Ignoring applications with the same name but without matching ownership", "name", name,
+			"ignored", len(apps)-len(owned))
+	}
+	return owned, nil
 }
 
-func (c *client) createNewApplication(ctx context.Context, name, globalAccountID string) (uuid.UUID, error) {
-	newApplication := newIasApplication(name, globalAccountID)
-	res, err := c.api.CreateApplicationWithResponse(ctx, &api.CreateApplicationParams{}, newApplication)
+func (c *client) createNewApplication(ctx context.Context, name string, config ApplicationConfig, certificate *clientCertificate) (uuid.UUID, error) {
+	newApplication, err := newIasApplication(name, config)
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+	if certificate != nil {
+		newApplication.UrnSapIdentityApplicationSchemasExtensionSci10Authentication.ApiCertificates = &[]api.ApiCertificateData{
+			newCertificateData(*certificate),
+		}
+	}
+	res, err := c.api.CreateApplicationWithResponse(ctx, &api.CreateApplicationParams{ModifiedOnBehalfOf: c.modifiedOnBehalfOf()}, newApplication)
 	if err != nil {
 		return uuid.UUID{}, err
 	}
 
 	if res.StatusCode() != http.StatusCreated {
-		kcontrollerruntime.Log.Error(err, "Failed to create application", "name", name, "statusCode", res.StatusCode())
-		return uuid.UUID{}, errCreateApplication
+		logging.FromContext(ctx, logging.ComponentIAS).Error(err, "Failed to create application", "name", name, "statusCode", res.StatusCode(), "body", redact.Body(res.Body))
+		return uuid.UUID{}, classifyStatusCode(errCreateApplication, res.StatusCode())
 	}
 
 	return extractApplicationID(res)
 }
 
-func (c *client) createSecret(ctx context.Context, appID uuid.UUID) (*string, error) {
+func (c *client) createAPISecret(ctx context.Context, appID uuid.UUID) (*api.ApiSecretResponse, error) {
 	res, err := c.api.CreateApiSecretWithResponse(ctx, appID, newSecretRequest())
 	if err != nil {
 		return nil, err
 	}
 
 	if res.StatusCode() != http.StatusCreated {
-		kcontrollerruntime.Log.Error(err, "Failed to create api secret", "id", appID, "statusCode", res.StatusCode())
-		return nil, errCreateAPISecret
+		logging.FromContext(ctx, logging.ComponentIAS).Error(err, "Failed to create api secret", "id", appID, "statusCode", res.StatusCode(), "body", redact.Body(res.Body))
+		return nil, classifyStatusCode(errCreateAPISecret, res.StatusCode())
 	}
 
-	return res.JSON201.Secret, nil
+	return res.JSON201, nil
 }
 
 func (c *client) getClientID(ctx context.Context, appID uuid.UUID) (*string, error) {
@@ -243,13 +523,16 @@ func (c *client) getClientID(ctx context.Context, appID uuid.UUID) (*string, err
 	}
 
 	if applicationResponse.StatusCode() != http.StatusOK {
-		kcontrollerruntime.Log.Error(err, "Failed to retrieve client ID", "id", appID, "statusCode", applicationResponse.StatusCode())
-		return nil, errRetrieveClientID
+		logging.FromContext(ctx, logging.ComponentIAS).Error(err, "Failed to retrieve client ID", "id", appID, "statusCode", applicationResponse.StatusCode(), "body", redact.Body(applicationResponse.Body))
+		return nil, classifyStatusCode(errRetrieveClientID, applicationResponse.StatusCode())
 	}
 	return applicationResponse.JSON200.UrnSapIdentityApplicationSchemasExtensionSci10Authentication.ClientId, nil
 }
 
-func (c *client) deleteApplication(ctx context.Context, id uuid.UUID) error {
+// deleteApplication deletes the application with the given ID and records the deletion in the audit log for the given runtime.
+func (c *client) deleteApplication(ctx context.Context, id uuid.UUID, runtimeID string) (err error) {
+	defer func() { c.audit(audit.OperationDeleteApplication, runtimeID, id.String(), err) }()
+
 	res, err := c.api.DeleteApplicationWithResponse(ctx, id)
 	if err != nil {
 		return err
@@ -261,8 +544,8 @@ func (c *client) deleteApplication(ctx context.Context, id uuid.UUID) error {
 	}
 
 	if res.StatusCode() != http.StatusOK {
-		kcontrollerruntime.Log.Error(err, "Failed to delete application", "id", id, "statusCode", res.StatusCode())
-		return errDeleteApplication
+		logging.FromContext(ctx, logging.ComponentIAS).Error(err, "Failed to delete application", "id", id, "statusCode", res.StatusCode(), "body", redact.Body(res.Body))
+		return classifyStatusCode(errDeleteApplication, res.StatusCode())
 	}
 
 	return nil
@@ -281,11 +564,28 @@ func extractApplicationID(createAppResponse *api.CreateApplicationResponse) (uui
 	return parsedAppID, nil
 }
 
-func newIasApplication(name, globalAccountID string) api.Application {
+func toApplicationInfo(app api.ApplicationResponse) ApplicationInfo {
+	info := ApplicationInfo{
+		Name:            ptr.Deref(app.Name, ""),
+		Description:     ptr.Deref(app.Description, ""),
+		GlobalAccountID: ptr.Deref(app.GlobalAccount, ""),
+		Owner:           parseOwnership(app.Description),
+	}
+	if app.UrnSapIdentityApplicationSchemasExtensionSci10Authentication != nil {
+		info.ClientID = ptr.Deref(app.UrnSapIdentityApplicationSchemasExtensionSci10Authentication.ClientId, "")
+	}
+	if app.Id != nil {
+		info.ID = app.Id.String()
+	}
+	info.Created = created(app)
+	return info
+}
+
+func newIasApplication(name string, config ApplicationConfig) (api.Application, error) {
 	ssoType := api.OpenIdConnect
-	return api.Application{
+	app := api.Application{
 		Name:          &name,
-		GlobalAccount: ptr.To(globalAccountID),
+		GlobalAccount: ptr.To(config.GlobalAccountID),
 		Branding: &api.Branding{
 			DisplayName: &name,
 		},
@@ -296,6 +596,21 @@ func newIasApplication(name, globalAccountID string) api.Application {
 			SsoType: &ssoType,
 		},
 	}
+	if err := config.Template.apply(&app, templateData{RuntimeID: name, GlobalAccountID: config.GlobalAccountID}); err != nil {
+		return api.Application{}, err
+	}
+	app.Description = ptr.To(config.Owner.describe(app.Description))
+	return app, nil
+}
+
+func newCertificateData(certificate clientCertificate) api.ApiCertificateData {
+	d := "eventing-auth-manager"
+	return api.ApiCertificateData{
+		AuthorizationScopes: &[]api.AuthorizationScope{"oAuth"},
+		Base64Certificate:   certificate.base64DER,
+		Description:         &d,
+		Dn:                  &certificate.dn,
+	}
 }
 
 func newSecretRequest() api.CreateApiSecretJSONRequestBody {
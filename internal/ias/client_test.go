@@ -2,10 +2,14 @@ package ias
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -17,6 +21,8 @@ import (
 	eamoidcmocks "github.com/kyma-project/eventing-auth-manager/internal/ias/internal/oidc/mocks"
 )
 
+var testOwner = Ownership{RuntimeID: "Test-App-Name", KcpNamespace: "kcp-system", InstanceID: "test-instance"}
+
 func Test_CreateApplication(t *testing.T) {
 	appID := uuid.MustParse("90764f89-f041-4ccf-8da9-7a7c2d60d7fc")
 	tests := []struct {
@@ -120,7 +126,7 @@ func Test_CreateApplication(t *testing.T) {
 				return &clientMock
 			},
 			wantApp:   Application{},
-			wantError: errors.New("found multiple applications with the same name Test-App-Name"), //nolint:goerr113 // used one time only in tests.
+			wantError: errors.New("found multiple applications with the same name Test-App-Name: 90764f89-f041-4ccf-8da9-7a7c2d60d7fc, 41de6fec-e0fc-47d7-b35c-3b19c4927e4f"), //nolint:goerr113 // used one time only in tests.
 		},
 		{
 			name: "should return error when application ID can't be retrieved from location header",
@@ -273,7 +279,7 @@ func Test_CreateApplication(t *testing.T) {
 			}
 
 			// when
-			app, err := client.CreateApplication(context.TODO(), "Test-App-Name", "GAID")
+			app, err := client.CreateApplication(context.TODO(), "Test-App-Name", ApplicationConfig{GlobalAccountID: "GAID", Owner: testOwner, CredentialType: CredentialTypeSecret})
 
 			// then
 			require.Equal(t, tt.wantApp, app)
@@ -298,6 +304,135 @@ func Test_CreateApplication(t *testing.T) {
 	}
 }
 
+func Test_CreateApplication_WithCertificate(t *testing.T) {
+	// given
+	appID := uuid.MustParse("90764f89-f041-4ccf-8da9-7a7c2d60d7fc")
+	apiMock := &mocks.ClientWithResponsesInterface{}
+	mockGetAllApplicationsWithResponseStatusOkEmptyResponse(apiMock)
+	apiMock.On("CreateApplicationWithResponse", mock.Anything, mock.Anything, mock.MatchedBy(func(app api.Application) bool {
+		certs := app.UrnSapIdentityApplicationSchemasExtensionSci10Authentication.ApiCertificates
+		return certs != nil && len(*certs) == 1 && (*certs)[0].Base64Certificate != "" && *(*certs)[0].Dn == "CN=Test-App-Name"
+	})).Return(&api.CreateApplicationResponse{
+		HTTPResponse: &http.Response{
+			StatusCode: http.StatusCreated,
+			Header: map[string][]string{
+				"Location": {fmt.Sprintf("https://test.com/v1/Applications/%s", appID)},
+			},
+		},
+	}, nil)
+	mockGetApplicationWithResponseStatusOK(apiMock, appID)
+
+	client := client{
+		api:        apiMock,
+		oidcClient: mockClient(t, ptr.To("https://test.com/token"), ptr.To("https://test.com/certs")),
+	}
+
+	// when
+	app, err := client.CreateApplication(context.TODO(), "Test-App-Name", ApplicationConfig{GlobalAccountID: "GAID", Owner: testOwner, CredentialType: CredentialTypeCertificate})
+
+	// then
+	require.NoError(t, err)
+	require.True(t, app.HasCertificate())
+	require.Equal(t, appID.String(), app.GetID())
+
+	secret := app.ToSecret("test-secret", "test-ns")
+	require.Equal(t, []byte("clientIdMock"), secret.Data["client_id"])
+	require.NotEmpty(t, secret.Data["tls.crt"])
+	require.NotEmpty(t, secret.Data["tls.key"])
+	require.NotContains(t, secret.Data, "client_secret")
+
+	// the client certificate replaces the client secret
+	apiMock.AssertNotCalled(t, "CreateApiSecretWithResponse", mock.Anything, mock.Anything, mock.Anything)
+	apiMock.AssertExpectations(t)
+}
+
+func Test_UpdateApplication(t *testing.T) {
+	appID := uuid.MustParse("90764f89-f041-4ccf-8da9-7a7c2d60d7fc")
+	config := ApplicationConfig{GlobalAccountID: "GAID", Owner: testOwner, Template: ApplicationTemplate{Description: ptr.To("new")}}
+
+	tests := []struct {
+		name         string
+		givenAPIMock func() *mocks.ClientWithResponsesInterface
+		wantUpdated  bool
+		wantError    error
+	}{
+		{
+			name: "should patch application when settings differ",
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAllApplicationsWithResponseStatusOk(&clientMock, appID)
+				mockGetApplicationWithResponseStatusOKWithDescription(&clientMock, appID, "old")
+				mockPatchApplicationWithBodyWithResponse(&clientMock, appID, http.StatusOK)
+				return &clientMock
+			},
+			wantUpdated: true,
+		},
+		{
+			name: "should not patch application when settings are equal",
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAllApplicationsWithResponseStatusOk(&clientMock, appID)
+				mockGetApplicationWithResponseStatusOKWithDescription(&clientMock, appID, testOwner.describe(ptr.To("new")))
+				return &clientMock
+			},
+		},
+		{
+			name: "should do nothing when application doesn't exist",
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAllApplicationsWithResponseStatusOkEmptyResponse(&clientMock)
+				return &clientMock
+			},
+		},
+		{
+			name: "should return error when application can't be retrieved",
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAllApplicationsWithResponseStatusOk(&clientMock, appID)
+				mockGetApplicationWithResponseStatusInternalServerError(&clientMock)
+				return &clientMock
+			},
+			wantError: errRetrieveApplication,
+		},
+		{
+			name: "should return error when patch failed",
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAllApplicationsWithResponseStatusOk(&clientMock, appID)
+				mockGetApplicationWithResponseStatusOKWithDescription(&clientMock, appID, "old")
+				mockPatchApplicationWithBodyWithResponse(&clientMock, appID, http.StatusInternalServerError)
+				return &clientMock
+			},
+			wantError: errPatchApplication,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			apiMock := tt.givenAPIMock()
+
+			client := client{
+				api: apiMock,
+			}
+
+			// when
+			updated, err := client.UpdateApplication(context.TODO(), "Test-App-Name", config)
+
+			// then
+			if tt.wantError != nil {
+				require.Error(t, err)
+				require.EqualError(t, tt.wantError, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.wantUpdated, updated)
+
+			apiMock.AssertExpectations(t)
+		})
+	}
+}
+
 func Test_DeleteApplication(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -363,7 +498,114 @@ func Test_DeleteApplication(t *testing.T) {
 			}
 
 			// when
-			err := client.DeleteApplication(context.TODO(), "Test-App-Name")
+			err := client.DeleteApplication(context.TODO(), "Test-App-Name", testOwner)
+
+			// then
+			if tt.wantError != nil {
+				require.Error(t, err)
+				require.EqualError(t, tt.wantError, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			apiMock.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_ReleaseApplication(t *testing.T) {
+	// given
+	appID := uuid.MustParse("90764f89-f041-4ccf-8da9-7a7c2d60d7fc")
+	apiMock := &mocks.ClientWithResponsesInterface{}
+	mockGetAllApplicationsWithResponseStatusOk(apiMock, appID)
+	var body []byte
+	apiMock.On("PatchApplicationWithBodyWithResponse", mock.Anything, appID, mock.Anything, "application/json", mock.Anything).
+		Run(func(args mock.Arguments) {
+			body, _ = io.ReadAll(args.Get(4).(io.Reader))
+		}).
+		Return(&api.PatchApplicationResponse{HTTPResponse: &http.Response{StatusCode: http.StatusOK}}, nil)
+	client := client{api: apiMock}
+
+	// when
+	err := client.ReleaseApplication(context.TODO(), "Test-App-Name", testOwner)
+
+	// then
+	require.NoError(t, err)
+	releasedOwner := testOwner
+	releasedOwner.Released = true
+	wantBody, err := json.Marshal(applicationPatch{Operations: []patchOperation{
+		{Op: api.Replace, Path: "/description", Value: releasedOwner.describe(nil)},
+	}})
+	require.NoError(t, err)
+	require.JSONEq(t, string(wantBody), string(body))
+	apiMock.AssertExpectations(t)
+}
+
+func Test_ListApplications(t *testing.T) {
+	firstAppID := uuid.MustParse("90764f89-f041-4ccf-8da9-7a7c2d60d7fc")
+	secondAppID := uuid.MustParse("41de6fec-e0fc-47d7-b35c-3b19c4927e4f")
+	cursor := uuid.MustParse("5ab797c6-7c43-4d3d-9d4f-2b2ac5e1b0a5")
+	created := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		givenAPIMock func() *mocks.ClientWithResponsesInterface
+		wantApps     []ApplicationInfo
+		wantError    error
+	}{
+		{
+			name: "should return applications of all pages",
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAllApplicationsPage(&clientMock, &api.GetAllApplicationsParams{}, ptr.To(cursor.String()), api.ApplicationResponse{
+					Id:            &firstAppID,
+					Name:          ptr.To("first"),
+					Description:   ptr.To("first description"),
+					GlobalAccount: ptr.To("GAID"),
+					Meta:          &api.Meta{Created: &created},
+				})
+				mockGetAllApplicationsPage(&clientMock, &api.GetAllApplicationsParams{Cursor: &cursor}, nil, api.ApplicationResponse{
+					Id:   &secondAppID,
+					Name: ptr.To("second"),
+				})
+				return &clientMock
+			},
+			wantApps: []ApplicationInfo{
+				{ID: firstAppID.String(), Name: "first", Description: "first description", GlobalAccountID: "GAID", Created: created},
+				{ID: secondAppID.String(), Name: "second"},
+			},
+		},
+		{
+			name: "should return no applications when no application exists",
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAllApplicationsWithResponseStatusNotFound(&clientMock)
+				return &clientMock
+			},
+			wantApps: []ApplicationInfo{},
+		},
+		{
+			name: "should return error when applications can't be fetched",
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAllApplicationsWithResponseStatusInternalServerError(&clientMock)
+				return &clientMock
+			},
+			wantError: errListApplications,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			apiMock := tt.givenAPIMock()
+
+			client := client{
+				api: apiMock,
+			}
+
+			// when
+			apps, err := client.ListApplications(context.TODO(), Filter{})
 
 			// then
 			if tt.wantError != nil {
@@ -372,12 +614,202 @@ func Test_DeleteApplication(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 			}
+			require.Equal(t, tt.wantApps, apps)
+
+			apiMock.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_DeleteApplicationByID(t *testing.T) {
+	// given
+	appID := uuid.MustParse("90764f89-f041-4ccf-8da9-7a7c2d60d7fc")
+	apiMock := &mocks.ClientWithResponsesInterface{}
+	mockDeleteApplicationWithResponseStatusOk(apiMock, appID)
+	client := client{
+		api: apiMock,
+	}
+
+	// when
+	err := client.DeleteApplicationByID(context.TODO(), appID.String())
+
+	// then
+	require.NoError(t, err)
+	require.Error(t, client.DeleteApplicationByID(context.TODO(), "invalid"))
+	apiMock.AssertExpectations(t)
+}
+
+func Test_GetApplicationByID(t *testing.T) {
+	appID := uuid.MustParse("90764f89-f041-4ccf-8da9-7a7c2d60d7fc")
+
+	tests := []struct {
+		name             string
+		givenAPIMock     func() *mocks.ClientWithResponsesInterface
+		wantClientID     string
+		wantNotFound     bool
+		wantErrorMessage string
+	}{
+		{
+			name: "should return application",
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetApplicationWithResponseStatusOK(&clientMock, appID)
+				return &clientMock
+			},
+			wantClientID: "clientIdMock",
+		},
+		{
+			name: "should return nil when application does not exist",
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetApplicationWithResponseStatusNotFound(&clientMock)
+				return &clientMock
+			},
+			wantNotFound: true,
+		},
+		{
+			name: "should return error when application can't be retrieved",
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetApplicationWithResponseStatusInternalServerError(&clientMock)
+				return &clientMock
+			},
+			wantErrorMessage: "failed to retrieve application",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			apiMock := tt.givenAPIMock()
+			client := client{
+				api: apiMock,
+			}
+
+			// when
+			app, err := client.GetApplicationByID(context.TODO(), appID.String())
+
+			// then
+			if tt.wantErrorMessage != "" {
+				require.EqualError(t, err, tt.wantErrorMessage)
+				return
+			}
+			require.NoError(t, err)
+			if tt.wantNotFound {
+				require.Nil(t, app)
+				return
+			}
+			require.NotNil(t, app)
+			require.Equal(t, tt.wantClientID, app.ClientID)
+			apiMock.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_GetApplicationByClientID_InvalidClientID(t *testing.T) {
+	// given
+	apiMock := &mocks.ClientWithResponsesInterface{}
+	client := client{
+		api: apiMock,
+	}
+
+	// when
+	app, err := client.GetApplicationByClientID(context.TODO(), "x or name eq y")
+
+	// then
+	require.ErrorIs(t, err, ErrInvalidFilterValue)
+	require.Nil(t, app)
+	apiMock.AssertNotCalled(t, "GetAllApplicationsWithResponse", mock.Anything, mock.Anything)
+}
+
+func Test_GetApplicationByClientID(t *testing.T) {
+	clientID := "5a1f3ad4-3f0d-4ac9-9e4d-3f5b7c1a2e8b"
+	filter := `clientID eq 5a1f3ad4-3f0d-4ac9-9e4d-3f5b7c1a2e8b`
+	firstAppID := uuid.MustParse("90764f89-f041-4ccf-8da9-7a7c2d60d7fc")
+	secondAppID := uuid.MustParse("41de6fec-e0fc-47d7-b35c-3b19c4927e4f")
+	newApp := func(id uuid.UUID) api.ApplicationResponse {
+		return api.ApplicationResponse{
+			Id: ptr.To(id),
+			UrnSapIdentityApplicationSchemasExtensionSci10Authentication: &api.AuthenticationSchema{
+				ClientId: ptr.To(clientID),
+			},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		givenAPIMock     func() *mocks.ClientWithResponsesInterface
+		wantID           string
+		wantErrorMessage string
+	}{
+		{
+			name: "should return the application with the client ID",
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAllApplicationsPage(&clientMock, &api.GetAllApplicationsParams{Filter: &filter}, nil, newApp(firstAppID))
+				return &clientMock
+			},
+			wantID: firstAppID.String(),
+		},
+		{
+			name: "should return nil when no application has the client ID",
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAllApplicationsPage(&clientMock, &api.GetAllApplicationsParams{Filter: &filter}, nil)
+				return &clientMock
+			},
+		},
+		{
+			name: "should return error when multiple applications have the client ID",
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAllApplicationsPage(&clientMock, &api.GetAllApplicationsParams{Filter: &filter}, nil, newApp(firstAppID), newApp(secondAppID))
+				return &clientMock
+			},
+			wantErrorMessage: "found multiple applications with the same client ID " + clientID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			apiMock := tt.givenAPIMock()
+			client := client{
+				api: apiMock,
+			}
+
+			// when
+			app, err := client.GetApplicationByClientID(context.TODO(), clientID)
 
+			// then
+			if tt.wantErrorMessage != "" {
+				require.EqualError(t, err, tt.wantErrorMessage)
+				return
+			}
+			require.NoError(t, err)
+			if tt.wantID == "" {
+				require.Nil(t, app)
+			} else {
+				require.NotNil(t, app)
+				require.Equal(t, tt.wantID, app.ID)
+				require.Equal(t, clientID, app.ClientID)
+			}
 			apiMock.AssertExpectations(t)
 		})
 	}
 }
 
+func mockGetAllApplicationsPage(clientMock *mocks.ClientWithResponsesInterface, params *api.GetAllApplicationsParams, nextCursor *string, apps ...api.ApplicationResponse) {
+	clientMock.On("GetAllApplicationsWithResponse", mock.Anything, params).
+		Return(&api.GetAllApplicationsResponse{
+			HTTPResponse: &http.Response{
+				StatusCode: http.StatusOK,
+			},
+			JSON200: &api.ApplicationsResponse{
+				Applications: &apps,
+				NextCursor:   nextCursor,
+			},
+		}, nil).Once()
+}
+
 func mockGetAllApplicationsWithResponseStatusInternalServerError(clientMock *mocks.ClientWithResponsesInterface) {
 	clientMock.On("GetAllApplicationsWithResponse", mock.Anything, mock.Anything).
 		Return(&api.GetAllApplicationsResponse{
@@ -413,11 +845,12 @@ func mockGetAllApplicationsWithResponseStatusOk(clientMock *mocks.ClientWithResp
 	for _, appID := range appIds {
 		id := appID
 		appResponses = append(appResponses, api.ApplicationResponse{
-			Id: &id,
+			Id:          &id,
+			Description: ptr.To(testOwner.describe(nil)),
 		})
 	}
 
-	appsFilter := "name eq Test-App-Name"
+	appsFilter := `name eq Test-App-Name`
 	clientMock.On("GetAllApplicationsWithResponse", mock.Anything, &api.GetAllApplicationsParams{Filter: &appsFilter}).
 		Return(&api.GetAllApplicationsResponse{
 			HTTPResponse: &http.Response{
@@ -429,8 +862,13 @@ func mockGetAllApplicationsWithResponseStatusOk(clientMock *mocks.ClientWithResp
 		}, nil)
 }
 
+func newTestIasApplication() api.Application {
+	app, _ := newIasApplication("Test-App-Name", ApplicationConfig{GlobalAccountID: "GAID", Owner: testOwner})
+	return app
+}
+
 func mockCreateApplicationWithResponseStatusInternalServerError(clientMock *mocks.ClientWithResponsesInterface) {
-	clientMock.On("CreateApplicationWithResponse", mock.Anything, mock.Anything, newIasApplication("Test-App-Name", "GAID")).
+	clientMock.On("CreateApplicationWithResponse", mock.Anything, mock.Anything, newTestIasApplication()).
 		Return(&api.CreateApplicationResponse{
 			HTTPResponse: &http.Response{
 				StatusCode: http.StatusInternalServerError,
@@ -439,7 +877,7 @@ func mockCreateApplicationWithResponseStatusInternalServerError(clientMock *mock
 }
 
 func mockCreateApplicationWithResponseStatusCreated(clientMock *mocks.ClientWithResponsesInterface, appID string) {
-	clientMock.On("CreateApplicationWithResponse", mock.Anything, mock.Anything, newIasApplication("Test-App-Name", "GAID")).
+	clientMock.On("CreateApplicationWithResponse", mock.Anything, mock.Anything, newTestIasApplication()).
 		Return(&api.CreateApplicationResponse{
 			HTTPResponse: &http.Response{
 				StatusCode: http.StatusCreated,
@@ -486,6 +924,15 @@ func mockGetApplicationWithResponseStatusInternalServerError(clientMock *mocks.C
 		}, nil)
 }
 
+func mockGetApplicationWithResponseStatusNotFound(clientMock *mocks.ClientWithResponsesInterface) {
+	clientMock.On("GetApplicationWithResponse", mock.Anything, mock.Anything, mock.Anything).
+		Return(&api.GetApplicationResponse{
+			HTTPResponse: &http.Response{
+				StatusCode: http.StatusNotFound,
+			},
+		}, nil)
+}
+
 func mockGetApplicationWithResponseStatusOK(clientMock *mocks.ClientWithResponsesInterface, appID uuid.UUID) {
 	cID := "clientIdMock"
 	clientMock.On("GetApplicationWithResponse", mock.Anything, appID, mock.Anything).
@@ -501,6 +948,28 @@ func mockGetApplicationWithResponseStatusOK(clientMock *mocks.ClientWithResponse
 		}, nil)
 }
 
+func mockGetApplicationWithResponseStatusOKWithDescription(clientMock *mocks.ClientWithResponsesInterface, appID uuid.UUID, description string) {
+	clientMock.On("GetApplicationWithResponse", mock.Anything, appID, mock.Anything).
+		Return(&api.GetApplicationResponse{
+			HTTPResponse: &http.Response{
+				StatusCode: http.StatusOK,
+			},
+			JSON200: &api.ApplicationResponse{
+				Id:          &appID,
+				Description: &description,
+			},
+		}, nil)
+}
+
+func mockPatchApplicationWithBodyWithResponse(clientMock *mocks.ClientWithResponsesInterface, appID uuid.UUID, statusCode int) {
+	clientMock.On("PatchApplicationWithBodyWithResponse", mock.Anything, appID, mock.Anything, "application/json", mock.Anything).
+		Return(&api.PatchApplicationResponse{
+			HTTPResponse: &http.Response{
+				StatusCode: statusCode,
+			},
+		}, nil)
+}
+
 func mockDeleteApplicationWithResponseStatusOk(clientMock *mocks.ClientWithResponsesInterface, appID uuid.UUID) {
 	clientMock.On("DeleteApplicationWithResponse", mock.Anything, appID).
 		Return(&api.DeleteApplicationResponse{
@@ -542,3 +1011,34 @@ func mockGetTokenEndpoint(t *testing.T, tokenURL *string) *eamoidcmocks.Client {
 	clientMock.On("GetTokenEndpoint", mock.Anything).Return(tokenURL, nil)
 	return clientMock
 }
+
+func Test_GetTokenURLAndJWKSURI_Concurrently(t *testing.T) {
+	// given
+	oidcMock := &eamoidcmocks.Client{}
+	oidcMock.On("GetTokenEndpoint", mock.Anything).Return(ptr.To("https://test.com/token"), nil).Once()
+	oidcMock.On("GetJWKSURI", mock.Anything).Return(ptr.To("https://test.com/certs"), nil).Once()
+	c := &client{oidcClient: oidcMock}
+
+	// when
+	const workers = 10
+	errs := make(chan error, 2*workers)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.GetTokenURL(context.TODO())
+			errs <- err
+			_, err = c.GetJWKSURI(context.TODO())
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	// then
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	oidcMock.AssertExpectations(t)
+}
@@ -0,0 +1,129 @@
+package ias
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api"
+	"github.com/kyma-project/eventing-auth-manager/internal/logging"
+)
+
+// DuplicatePolicy defines how multiple applications with the same name are resolved.
+type DuplicatePolicy string
+
+const (
+	// DuplicatePolicyFail does not resolve duplicates, so that they must be resolved manually.
+	DuplicatePolicyFail DuplicatePolicy = "fail"
+	// DuplicatePolicyKeepNewest keeps the most recently created application and deletes all others.
+	DuplicatePolicyKeepNewest DuplicatePolicy = "keep-newest"
+	// DuplicatePolicyKeepMatchingStatusUUID keeps the application whose ID is recorded in the status of the EventingAuth CR and deletes all others.
+	DuplicatePolicyKeepMatchingStatusUUID DuplicatePolicy = "keep-matching-status-uuid"
+)
+
+var errNoDuplicateMatchesStatusUUID = errors.New("none of the duplicate applications matches the application ID of the status")
+
+// ParseDuplicatePolicy returns the duplicate policy for the given value or an error if the value is not a valid policy.
+func ParseDuplicatePolicy(value string) (DuplicatePolicy, error) {
+	switch p := DuplicatePolicy(value); p {
+	case DuplicatePolicyFail, DuplicatePolicyKeepNewest, DuplicatePolicyKeepMatchingStatusUUID:
+		return p, nil
+	default:
+		return "", errors.Errorf("invalid duplicate application policy %q, must be one of %s, %s, %s", value,
+			DuplicatePolicyFail, DuplicatePolicyKeepNewest, DuplicatePolicyKeepMatchingStatusUUID)
+	}
+}
+
+// DuplicateApplicationsError is returned if multiple applications with the same name exist.
+type DuplicateApplicationsError struct {
+	Name string
+	IDs  []string
+}
+
+func (e *DuplicateApplicationsError) Error() string {
+	return fmt.Sprintf("found multiple applications with the same name %s: %s", e.Name, strings.Join(e.IDs, ", "))
+}
+
+func newDuplicateApplicationsError(name string, apps []api.ApplicationResponse) *DuplicateApplicationsError {
+	ids := make([]string, 0, len(apps))
+	for _, app := range apps {
+		if app.Id != nil {
+			ids = append(ids, app.Id.String())
+		}
+	}
+	return &DuplicateApplicationsError{Name: name, IDs: ids}
+}
+
+// ResolveDuplicateApplications resolves multiple applications with the given name and matching ownership according to the policy by
+// deleting all but one application. The application ID of the ownership is the ID recorded in the status of the EventingAuth CR and
+// is used by the policy DuplicatePolicyKeepMatchingStatusUUID. It returns the ID of the kept application or an empty string if no
+// duplicates exist. If the duplicates can't be resolved by the policy, a DuplicateApplicationsError is returned.
+func (c *client) ResolveDuplicateApplications(ctx context.Context, name string, policy DuplicatePolicy, owner Ownership) (string, error) {
+	apps, err := c.getOwnedApplicationsByName(ctx, name, owner)
+	if err != nil {
+		return "", err
+	}
+	if len(apps) <= 1 {
+		return "", nil
+	}
+
+	duplicatesErr := newDuplicateApplicationsError(name, apps)
+	var keep *api.ApplicationResponse
+	switch policy {
+	case DuplicatePolicyKeepNewest:
+		keep = newestApplication(apps)
+	case DuplicatePolicyKeepMatchingStatusUUID:
+		keep = applicationWithID(apps, owner.ApplicationID)
+		if keep == nil {
+			return "", errors.Wrap(duplicatesErr, errNoDuplicateMatchesStatusUUID.Error())
+		}
+	case DuplicatePolicyFail:
+		return "", duplicatesErr
+	default:
+		return "", errors.Errorf("unsupported duplicate application policy %s", policy)
+	}
+
+	for _, app := range apps {
+		if app.Id == nil || *app.Id == *keep.Id {
+			continue
+		}
+		if err := c.deleteApplication(ctx, *app.Id, name); err != nil {
+			return "", err
+		}
+		logging.FromContext(ctx, logging.ComponentIAS).Info("Deleted duplicate application", "name", name, "id", *app.Id, "keptId", *keep.Id, "policy", policy)
+	}
+	return keep.Id.String(), nil
+}
+
+// newestApplication returns the most recently created application. Applications without creation time are considered the oldest.
+func newestApplication(apps []api.ApplicationResponse) *api.ApplicationResponse {
+	var newest *api.ApplicationResponse
+	for i := range apps {
+		if apps[i].Id == nil {
+			continue
+		}
+		if newest == nil || created(apps[i]).After(created(*newest)) {
+			newest = &apps[i]
+		}
+	}
+	return newest
+}
+
+func created(app api.ApplicationResponse) time.Time {
+	if app.Meta == nil || app.Meta.Created == nil {
+		return time.Time{}
+	}
+	return *app.Meta.Created
+}
+
+func applicationWithID(apps []api.ApplicationResponse, id string) *api.ApplicationResponse {
+	for i := range apps {
+		if apps[i].Id != nil && apps[i].Id.String() == id {
+			return &apps[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package ias
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api"
+	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api/mocks"
+)
+
+func Test_ResolveDuplicateApplications(t *testing.T) {
+	olderAppID := uuid.MustParse("90764f89-f041-4ccf-8da9-7a7c2d60d7fc")
+	newerAppID := uuid.MustParse("41de6fec-e0fc-47d7-b35c-3b19c4927e4f")
+	older := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+	filter := `name eq Test-App-Name`
+	duplicates := []api.ApplicationResponse{
+		{Id: &olderAppID, Description: ptr.To(testOwner.describe(nil)), Meta: &api.Meta{Created: &older}},
+		{Id: &newerAppID, Description: ptr.To(testOwner.describe(nil)), Meta: &api.Meta{Created: &newer}},
+		// applications of other owners are never deleted
+		{Id: ptr.To(uuid.New()), Description: ptr.To("foreign"), Meta: &api.Meta{Created: &newer}},
+	}
+
+	tests := []struct {
+		name           string
+		givenPolicy    DuplicatePolicy
+		givenStatusID  string
+		givenAPIMock   func() *mocks.ClientWithResponsesInterface
+		wantKeptID     string
+		wantDuplicates bool
+	}{
+		{
+			name:        "should do nothing when no duplicates exist",
+			givenPolicy: DuplicatePolicyKeepNewest,
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAllApplicationsPage(&clientMock, &api.GetAllApplicationsParams{Filter: &filter}, nil, duplicates[0], duplicates[2])
+				return &clientMock
+			},
+		},
+		{
+			name:        "should return duplicates error with policy fail",
+			givenPolicy: DuplicatePolicyFail,
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAllApplicationsPage(&clientMock, &api.GetAllApplicationsParams{Filter: &filter}, nil, duplicates...)
+				return &clientMock
+			},
+			wantDuplicates: true,
+		},
+		{
+			name:        "should keep newest application",
+			givenPolicy: DuplicatePolicyKeepNewest,
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAllApplicationsPage(&clientMock, &api.GetAllApplicationsParams{Filter: &filter}, nil, duplicates...)
+				mockDeleteApplicationWithResponseStatusOk(&clientMock, olderAppID)
+				return &clientMock
+			},
+			wantKeptID: newerAppID.String(),
+		},
+		{
+			name:          "should keep application matching the status UUID",
+			givenPolicy:   DuplicatePolicyKeepMatchingStatusUUID,
+			givenStatusID: olderAppID.String(),
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAllApplicationsPage(&clientMock, &api.GetAllApplicationsParams{Filter: &filter}, nil, duplicates...)
+				mockDeleteApplicationWithResponseStatusOk(&clientMock, newerAppID)
+				return &clientMock
+			},
+			wantKeptID: olderAppID.String(),
+		},
+		{
+			name:          "should return duplicates error when no application matches the status UUID",
+			givenPolicy:   DuplicatePolicyKeepMatchingStatusUUID,
+			givenStatusID: uuid.NewString(),
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAllApplicationsPage(&clientMock, &api.GetAllApplicationsParams{Filter: &filter}, nil, duplicates...)
+				return &clientMock
+			},
+			wantDuplicates: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			apiMock := tt.givenAPIMock()
+
+			client := client{
+				api: apiMock,
+			}
+
+			owner := testOwner
+			owner.ApplicationID = tt.givenStatusID
+
+			// when
+			keptID, err := client.ResolveDuplicateApplications(context.TODO(), "Test-App-Name", tt.givenPolicy, owner)
+
+			// then
+			if tt.wantDuplicates {
+				var duplicatesErr *DuplicateApplicationsError
+				require.ErrorAs(t, err, &duplicatesErr)
+				require.Equal(t, []string{olderAppID.String(), newerAppID.String()}, duplicatesErr.IDs)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.wantKeptID, keptID)
+
+			apiMock.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_ParseDuplicatePolicy(t *testing.T) {
+	policy, err := ParseDuplicatePolicy("keep-newest")
+	require.NoError(t, err)
+	require.Equal(t, DuplicatePolicyKeepNewest, policy)
+
+	_, err = ParseDuplicatePolicy("keep-all")
+	require.Error(t, err)
+}
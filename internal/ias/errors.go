@@ -0,0 +1,47 @@
+package ias
+
+import (
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// Errors that classify the cause of a failed request to IAS. They are matched with errors.Is, while the message of the returned error
+// remains the one of the failed operation.
+var (
+	ErrUnauthorized        = errors.New("IAS rejected the credentials")
+	ErrRateLimited         = errors.New("IAS rate limit exceeded")
+	ErrOIDCDiscoveryFailed = errors.New("failed to discover the OIDC configuration of IAS")
+	ErrCredentialsInvalid  = errors.New("IAS credentials are invalid")
+)
+
+// classifiedError keeps the message of the error, but additionally matches the cause with errors.Is.
+type classifiedError struct {
+	err   error
+	cause error
+}
+
+func (e *classifiedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *classifiedError) Unwrap() []error {
+	return []error{e.err, e.cause}
+}
+
+// classifyStatusCode classifies the error of a request to IAS that failed with the given status code.
+func classifyStatusCode(err error, statusCode int) error {
+	switch statusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return &classifiedError{err: err, cause: ErrUnauthorized}
+	case http.StatusTooManyRequests:
+		return &classifiedError{err: err, cause: ErrRateLimited}
+	default:
+		return err
+	}
+}
+
+// classifyOIDCDiscovery classifies the error of a failed request for the OIDC configuration.
+func classifyOIDCDiscovery(err error) error {
+	return &classifiedError{err: err, cause: ErrOIDCDiscoveryFailed}
+}
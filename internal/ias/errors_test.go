@@ -0,0 +1,89 @@
+package ias
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api"
+	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api/mocks"
+	eamoidcmocks "github.com/kyma-project/eventing-auth-manager/internal/ias/internal/oidc/mocks"
+)
+
+func Test_classifyStatusCode(t *testing.T) {
+	tests := []struct {
+		name            string
+		givenStatusCode int
+		wantCause       error
+	}{
+		{
+			name:            "should classify unauthorized request",
+			givenStatusCode: http.StatusUnauthorized,
+			wantCause:       ErrUnauthorized,
+		},
+		{
+			name:            "should classify forbidden request as unauthorized",
+			givenStatusCode: http.StatusForbidden,
+			wantCause:       ErrUnauthorized,
+		},
+		{
+			name:            "should classify rate limited request",
+			givenStatusCode: http.StatusTooManyRequests,
+			wantCause:       ErrRateLimited,
+		},
+		{
+			name:            "should not classify internal server error",
+			givenStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			err := classifyStatusCode(errCreateApplication, tt.givenStatusCode)
+
+			// then
+			require.ErrorIs(t, err, errCreateApplication)
+			require.EqualError(t, err, errCreateApplication.Error())
+			for _, cause := range []error{ErrUnauthorized, ErrRateLimited} {
+				require.Equal(t, cause == tt.wantCause, errors.Is(err, cause))
+			}
+		})
+	}
+}
+
+func Test_client_ClassifiesFailures(t *testing.T) {
+	t.Run("should classify unauthorized request to list applications", func(t *testing.T) {
+		// given
+		apiMock := &mocks.ClientWithResponsesInterface{}
+		apiMock.On("GetAllApplicationsWithResponse", mock.Anything, mock.Anything).
+			Return(&api.GetAllApplicationsResponse{
+				HTTPResponse: &http.Response{StatusCode: http.StatusUnauthorized},
+			}, nil)
+		c := client{api: apiMock}
+
+		// when
+		_, err := c.CreateApplication(context.TODO(), "Test-App-Name", ApplicationConfig{Owner: testOwner})
+
+		// then
+		require.EqualError(t, err, errFetchExistingApplications.Error())
+		require.ErrorIs(t, err, ErrUnauthorized)
+	})
+
+	t.Run("should classify failed OIDC discovery", func(t *testing.T) {
+		// given
+		oidcMock := &eamoidcmocks.Client{}
+		oidcMock.On("GetTokenEndpoint", mock.Anything).Return(nil, errors.New("connection refused")) //nolint:goerr113 // used one time only in tests.
+		c := client{oidcClient: oidcMock}
+
+		// when
+		_, err := c.GetTokenURL(context.TODO())
+
+		// then
+		require.EqualError(t, err, "connection refused")
+		require.ErrorIs(t, err, ErrOIDCDiscoveryFailed)
+	})
+}
@@ -0,0 +1,79 @@
+package ias
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// FilterAttribute is an attribute of the applications that is supported in filters.
+type FilterAttribute string
+
+const (
+	FilterAttributeID                  FilterAttribute = "id"
+	FilterAttributeParentApplicationID FilterAttribute = "parentApplicationId"
+	FilterAttributeName                FilterAttribute = "name"
+	FilterAttributeClientID            FilterAttribute = "clientID"
+)
+
+// Filter selects the applications whose attribute equals the value. The zero value matches all applications.
+type Filter struct {
+	attribute FilterAttribute
+	value     string
+}
+
+// ErrInvalidFilterValue is returned for filter values that could change the filter expression.
+var ErrInvalidFilterValue = errors.New("invalid filter value")
+
+// FilterByID returns a filter matching the application with the given ID.
+func FilterByID(id string) (Filter, error) {
+	return newFilter(FilterAttributeID, id)
+}
+
+// FilterByParentApplicationID returns a filter matching the applications inheriting from the given parent application.
+func FilterByParentApplicationID(id string) (Filter, error) {
+	return newFilter(FilterAttributeParentApplicationID, id)
+}
+
+// FilterByName returns a filter matching the applications with the given name.
+func FilterByName(name string) (Filter, error) {
+	return newFilter(FilterAttributeName, name)
+}
+
+// FilterByClientID returns a filter matching the application with the given client ID.
+func FilterByClientID(clientID string) (Filter, error) {
+	return newFilter(FilterAttributeClientID, clientID)
+}
+
+// newFilter returns a filter for the attribute and value. The value is written unquoted into the filter expression, so values that
+// are empty or contain whitespace, quotes, backslashes or control characters are rejected, since they could change the expression,
+// e.g. "x or name eq y".
+func newFilter(attribute FilterAttribute, value string) (Filter, error) {
+	if value == "" {
+		return Filter{}, errors.Wrapf(ErrInvalidFilterValue, "%s must not be empty", attribute)
+	}
+	if i := strings.IndexFunc(value, isUnsafeFilterRune); i >= 0 {
+		return Filter{}, errors.Wrapf(ErrInvalidFilterValue, "%s %q contains the character %q", attribute, value, []rune(value[i:])[0])
+	}
+	return Filter{attribute: attribute, value: value}, nil
+}
+
+func isUnsafeFilterRune(r rune) bool {
+	return unicode.IsSpace(r) || unicode.IsControl(r) || r == '"' || r == '\'' || r == '\\'
+}
+
+// IsEmpty returns true if the filter matches all applications.
+func (f Filter) IsEmpty() bool {
+	return f.attribute == ""
+}
+
+// String returns the filter expression, e.g. "name eq <name>", or an empty string if the filter matches all applications. The value
+// is validated when the filter is created, so it can't change the expression.
+func (f Filter) String() string {
+	if f.IsEmpty() {
+		return ""
+	}
+	return fmt.Sprintf("%s eq %s", f.attribute, f.value)
+}
@@ -0,0 +1,111 @@
+package ias
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Filter_String(t *testing.T) {
+	tests := []struct {
+		name        string
+		givenFilter func() (Filter, error)
+		want        string
+	}{
+		{
+			name:        "should return empty expression for empty filter",
+			givenFilter: func() (Filter, error) { return Filter{}, nil },
+			want:        "",
+		},
+		{
+			name:        "should return expression for name",
+			givenFilter: func() (Filter, error) { return FilterByName("Test-App-Name") },
+			want:        "name eq Test-App-Name",
+		},
+		{
+			name:        "should return expression for ID",
+			givenFilter: func() (Filter, error) { return FilterByID("90764f89-f041-4ccf-8da9-7a7c2d60d7fc") },
+			want:        "id eq 90764f89-f041-4ccf-8da9-7a7c2d60d7fc",
+		},
+		{
+			name:        "should return expression for client ID",
+			givenFilter: func() (Filter, error) { return FilterByClientID("client-id") },
+			want:        "clientID eq client-id",
+		},
+		{
+			name:        "should return expression for parent application ID",
+			givenFilter: func() (Filter, error) { return FilterByParentApplicationID("parent-id") },
+			want:        "parentApplicationId eq parent-id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			filter, err := tt.givenFilter()
+			require.NoError(t, err)
+
+			// when
+			got := filter.String()
+
+			// then
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.want == "", filter.IsEmpty())
+		})
+	}
+}
+
+func Test_Filter_InvalidValue(t *testing.T) {
+	tests := []struct {
+		name       string
+		givenValue string
+	}{
+		{
+			name:       "should reject empty value",
+			givenValue: "",
+		},
+		{
+			name:       "should reject value with spaces and operators",
+			givenValue: "x or name eq y",
+		},
+		{
+			name:       "should reject value with double quotes",
+			givenValue: `app"or"x`,
+		},
+		{
+			name:       "should reject value with single quotes",
+			givenValue: "app'x",
+		},
+		{
+			name:       "should reject value with backslash",
+			givenValue: `app\x`,
+		},
+		{
+			name:       "should reject value with tab",
+			givenValue: "app\tx",
+		},
+		{
+			name:       "should reject value with line break",
+			givenValue: "app\nor",
+		},
+		{
+			name:       "should reject value with control character",
+			givenValue: "app\x00",
+		},
+		{
+			name:       "should reject value with non-breaking space",
+			givenValue: "app\u00a0or",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, newFilter := range []func(string) (Filter, error){FilterByID, FilterByParentApplicationID, FilterByName, FilterByClientID} {
+				// when
+				filter, err := newFilter(tt.givenValue)
+
+				// then
+				require.ErrorIs(t, err, ErrInvalidFilterValue)
+				require.True(t, filter.IsEmpty())
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package ias
 
 import (
 	"context"
+	"net/url"
+	"strings"
 
 	"github.com/pkg/errors"
 	kcorev1 "k8s.io/api/core/v1"
@@ -29,7 +31,8 @@ type Credentials struct {
 	Password string
 }
 
-// ReadCredentials fetches ias credentials from secret in the cluster. Reads from env vars if secret is missing.
+// ReadCredentials fetches ias credentials from secret in the cluster. Reads from env vars if secret is missing. Missing keys and an
+// invalid tenant URL are returned as ErrCredentialsInvalid.
 var ReadCredentials = func(namespace, name string, k8sClient kpkgclient.Client) (*Credentials, error) { //nolint:gochecknoglobals // For mocking purposes.
 	namespacedName := types.NamespacedName{
 		Namespace: namespace,
@@ -41,9 +44,9 @@ var ReadCredentials = func(namespace, name string, k8sClient kpkgclient.Client)
 	}
 
 	var exists bool
-	var url, username, password []byte
+	var tenantURL, username, password []byte
 	var err error
-	if url, exists = iasSecret.Data[urlString]; !exists {
+	if tenantURL, exists = iasSecret.Data[urlString]; !exists {
 		err = errors.Errorf("key %s is not found in ias secret", urlString)
 	}
 	if username, exists = iasSecret.Data[usernameString]; !exists {
@@ -60,9 +63,50 @@ var ReadCredentials = func(namespace, name string, k8sClient kpkgclient.Client)
 			err = errors.Errorf("key %s is not found in ias secret", passwordString)
 		}
 	}
+	var normalizedURL string
+	if err == nil {
+		normalizedURL, err = normalizeURL(string(tenantURL))
+	}
 	if err != nil {
-		return nil, err
+		return nil, &classifiedError{err: err, cause: ErrCredentialsInvalid}
 	}
-	iasConfig := NewCredentials(string(url), string(username), string(password))
+	iasConfig := NewCredentials(normalizedURL, string(username), string(password))
 	return iasConfig, nil
 }
+
+// CABundleKey is the key of the PEM encoded CA bundle in the CA bundle secret.
+const CABundleKey = "ca.crt"
+
+// ReadCABundle fetches the CA bundle that is trusted by the IAS client from the key ca.crt of the given secret. A missing key and a CA
+// bundle without certificates are returned as ErrCredentialsInvalid.
+func ReadCABundle(ctx context.Context, name types.NamespacedName, k8sClient kpkgclient.Client) ([]byte, error) {
+	caSecret := &kcorev1.Secret{}
+	if err := k8sClient.Get(ctx, name, caSecret); err != nil {
+		return nil, err
+	}
+	caBundle, exists := caSecret.Data[CABundleKey]
+	if !exists {
+		return nil, &classifiedError{err: errors.Errorf("key %s is not found in ias CA bundle secret", CABundleKey), cause: ErrCredentialsInvalid}
+	}
+	if err := validateCABundle(caBundle); err != nil {
+		return nil, &classifiedError{err: errors.Wrapf(err, "key %s in ias CA bundle secret is invalid", CABundleKey), cause: ErrCredentialsInvalid}
+	}
+	return caBundle, nil
+}
+
+// normalizeURL checks that the tenant URL is an https URL without path, because the paths of the IAS APIs are appended to it. A single
+// trailing slash is removed from the returned URL.
+func normalizeURL(tenantURL string) (string, error) {
+	trimmedURL := strings.TrimSuffix(tenantURL, "/")
+	u, err := url.Parse(trimmedURL)
+	if err != nil {
+		return "", errors.Wrapf(err, "key %s in ias secret is not a valid URL", urlString)
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return "", errors.Errorf("key %s in ias secret must be an https URL, e.g. https://tenant.accounts.ondemand.com, but is %q", urlString, tenantURL)
+	}
+	if u.Path != "" || u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return "", errors.Errorf("key %s in ias secret must not contain a path, query, fragment or user info, but is %q", urlString, tenantURL)
+	}
+	return trimmedURL, nil
+}
@@ -1,12 +1,14 @@
 package ias
 
 import (
+	"context"
 	"testing"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	kcorev1 "k8s.io/api/core/v1"
 	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	kpkgclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/mocks"
@@ -25,6 +27,8 @@ func Test_ReadCredentials(t *testing.T) {
 		mockEnvVars        func()
 		wantCredentials    Credentials
 		wantError          error
+		// wantCredentialsInvalid is true if the error is classified as ErrCredentialsInvalid.
+		wantCredentialsInvalid bool
 	}{
 		{
 			name: "Reads credentials from secret successfully",
@@ -60,6 +64,7 @@ func Test_ReadCredentials(t *testing.T) {
 			},
 			wantError: errors.Errorf("key %s is not found in ias secret: key %s is not found in ias secret: key %s is not found in ias secret",
 				passwordString, usernameString, urlString),
+			wantCredentialsInvalid: true,
 		},
 		{
 			name: "Fails with missing username data fields error",
@@ -69,7 +74,8 @@ func Test_ReadCredentials(t *testing.T) {
 				},
 				MockSecret: createMockSecretWithoutUsernameDataFields(testNamespace, testName, testURL, testPassword),
 			},
-			wantError: errors.Errorf("key %s is not found in ias secret", usernameString),
+			wantError:              errors.Errorf("key %s is not found in ias secret", usernameString),
+			wantCredentialsInvalid: true,
 		},
 		{
 			name: "Fails with missing username and password data fields error",
@@ -79,7 +85,58 @@ func Test_ReadCredentials(t *testing.T) {
 				},
 				MockSecret: createMockSecretWithoutUsernameAndPasswordDataFields(testNamespace, testName, testURL),
 			},
-			wantError: errors.Errorf("key %s is not found in ias secret: key %s is not found in ias secret", passwordString, usernameString),
+			wantError:              errors.Errorf("key %s is not found in ias secret: key %s is not found in ias secret", passwordString, usernameString),
+			wantCredentialsInvalid: true,
+		},
+		{
+			name: "Fails with URL without https",
+			givenK8sClientMock: &mocks.MockClient{
+				MockFunction: func() error {
+					return nil
+				},
+				MockSecret: createMockSecret(testNamespace, testName, "http://test.url.com", testUsername, testPassword),
+			},
+			wantError: errors.Errorf("key %s in ias secret must be an https URL, e.g. https://tenant.accounts.ondemand.com, but is %q",
+				urlString, "http://test.url.com"),
+			wantCredentialsInvalid: true,
+		},
+		{
+			name: "Reads credentials with trailing slash removed from URL",
+			givenK8sClientMock: &mocks.MockClient{
+				MockFunction: func() error {
+					return nil
+				},
+				MockSecret: createMockSecret(testNamespace, testName, testURL+"/", testUsername, testPassword),
+			},
+			wantCredentials: Credentials{
+				URL:      testURL,
+				Username: testUsername,
+				Password: testPassword,
+			},
+		},
+		{
+			name: "Fails with URL with path",
+			givenK8sClientMock: &mocks.MockClient{
+				MockFunction: func() error {
+					return nil
+				},
+				MockSecret: createMockSecret(testNamespace, testName, "https://test.url.com/path/", testUsername, testPassword),
+			},
+			wantError: errors.Errorf("key %s in ias secret must not contain a path, query, fragment or user info, but is %q",
+				urlString, "https://test.url.com/path/"),
+			wantCredentialsInvalid: true,
+		},
+		{
+			name: "Fails with URL with more than one trailing slash",
+			givenK8sClientMock: &mocks.MockClient{
+				MockFunction: func() error {
+					return nil
+				},
+				MockSecret: createMockSecret(testNamespace, testName, "https://test.url.com//", testUsername, testPassword),
+			},
+			wantError: errors.Errorf("key %s in ias secret must not contain a path, query, fragment or user info, but is %q",
+				urlString, "https://test.url.com//"),
+			wantCredentialsInvalid: true,
 		},
 	}
 
@@ -91,6 +148,7 @@ func Test_ReadCredentials(t *testing.T) {
 			if tt.wantError != nil {
 				require.Error(t, err)
 				require.EqualError(t, tt.wantError, err.Error())
+				require.Equal(t, tt.wantCredentialsInvalid, errors.Is(err, ErrCredentialsInvalid))
 			} else {
 				require.Equal(t, tt.wantCredentials, *actualCredentials)
 			}
@@ -98,6 +156,67 @@ func Test_ReadCredentials(t *testing.T) {
 	}
 }
 
+func Test_ReadCABundle(t *testing.T) {
+	certificate, err := newClientCertificate("test-ca")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		givenData    map[string][]byte
+		givenGetErr  error
+		wantCABundle []byte
+		wantError    string
+		// wantCredentialsInvalid is true if the error is classified as ErrCredentialsInvalid.
+		wantCredentialsInvalid bool
+	}{
+		{
+			name:         "Reads CA bundle from secret successfully",
+			givenData:    map[string][]byte{CABundleKey: certificate.certificatePEM},
+			wantCABundle: certificate.certificatePEM,
+		},
+		{
+			name:        "Fails with an error",
+			givenGetErr: errors.New("mock error"),
+			wantError:   "mock error",
+		},
+		{
+			name:                   "Fails with missing CA bundle key",
+			givenData:              map[string][]byte{},
+			wantError:              "key ca.crt is not found in ias CA bundle secret",
+			wantCredentialsInvalid: true,
+		},
+		{
+			name:                   "Fails with CA bundle without certificates",
+			givenData:              map[string][]byte{CABundleKey: []byte("not a certificate")},
+			wantError:              "key ca.crt in ias CA bundle secret is invalid: CA bundle doesn't contain any PEM encoded certificate",
+			wantCredentialsInvalid: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			k8sClientMock := &mocks.MockClient{
+				MockFunction: func() error {
+					return tt.givenGetErr
+				},
+				MockSecret: &kcorev1.Secret{Data: tt.givenData},
+			}
+
+			// when
+			caBundle, err := ReadCABundle(context.TODO(), types.NamespacedName{Namespace: "mock-namespace", Name: "mock-name"}, k8sClientMock)
+
+			// then
+			if tt.wantError != "" {
+				require.EqualError(t, err, tt.wantError)
+				require.Equal(t, tt.wantCredentialsInvalid, errors.Is(err, ErrCredentialsInvalid))
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.wantCABundle, caBundle)
+			}
+		})
+	}
+}
+
 func createMockSecret(testNamespace, testName, testURL, testUsername, testPassword string) *kcorev1.Secret {
 	s := &kcorev1.Secret{
 		ObjectMeta: kmetav1.ObjectMeta{
@@ -8,6 +8,9 @@ import (
 	"net/http"
 
 	"github.com/pkg/errors"
+
+	"github.com/kyma-project/eventing-auth-manager/internal/redact"
+	"github.com/kyma-project/eventing-auth-manager/internal/tracing"
 )
 
 //go:generate mockery --name=Client --outpkg=mocks --case=underscore
@@ -16,18 +19,26 @@ type Client interface {
 	GetJWKSURI(ctx context.Context) (*string, error)
 }
 
+// maxErrorBodyLength is the maximum number of bytes read from the body of a failed response.
+const maxErrorBodyLength = 4096
+
 type wellKnown struct {
 	TokenEndpoint *string `json:"token_endpoint,omitempty"`
 	JWKSURI       *string `json:"jwks_uri,omitempty"`
 }
 
+// HTTPClient sends the requests to the OIDC configuration, e.g. an http.Client.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
 type client struct {
 	domainURL  string
-	httpClient *http.Client
+	httpClient HTTPClient
 }
 
 // NewOidcClient returns a new OIDC client. The domain URL is used to get the OIDC configuration for a specific tenant, e.g. 'https://some-tenant.accounts400.ondemand.com'.
-func NewOidcClient(h *http.Client, domainURL string) Client {
+func NewOidcClient(h HTTPClient, domainURL string) Client {
 	return client{
 		domainURL:  domainURL,
 		httpClient: h,
@@ -54,12 +65,16 @@ func (c client) GetJWKSURI(ctx context.Context) (*string, error) {
 	return w.JWKSURI, nil
 }
 
-func (c client) getWellKnown(ctx context.Context) (wellKnown, error) {
+func (c client) getWellKnown(ctx context.Context) (_ wellKnown, err error) {
+	ctx, span := tracing.StartSpan(ctx, "ias.oidc.GetWellKnown")
+	defer func() { tracing.EndSpan(span, err) }()
+
 	url := fmt.Sprintf("%s/.well-known/openid-configuration", c.domainURL)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return wellKnown{}, err
 	}
+	_ = tracing.InjectTraceContext(ctx, req)
 
 	body, err := c.do(req)
 	if err != nil {
@@ -74,20 +89,35 @@ func (c client) getWellKnown(ctx context.Context) (wellKnown, error) {
 	return w, nil
 }
 
+// errorDetail returns the redacted beginning of the body of a failed response to be appended to the error message.
+func errorDetail(body io.Reader) string {
+	if body == nil {
+		return ""
+	}
+	b, err := io.ReadAll(io.LimitReader(body, maxErrorBodyLength))
+	if err != nil {
+		return ""
+	}
+	if detail := redact.Body(b); detail != "" {
+		return ": " + detail
+	}
+	return ""
+}
+
 func (c client) do(req *http.Request) ([]byte, error) {
 	res, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
 
-	if res.StatusCode != http.StatusOK {
-		return nil, errors.Errorf("unexpected status code %d", res.StatusCode)
-	}
-
 	if res.Body != nil {
 		defer func() { _ = res.Body.Close() }()
 	}
 
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status code %d%s", res.StatusCode, errorDetail(res.Body))
+	}
+
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, err
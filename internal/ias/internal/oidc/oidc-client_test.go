@@ -67,6 +67,18 @@ func Test_oidcClient_getTokenUrl(t *testing.T) {
 			},
 			wantErr: errors.New("unexpected status code 500"), //nolint:goerr113 // used one time only in tests.
 		},
+		{
+			name: "should return error with redacted response body when response status code is not 200",
+			fields: fields{
+				httpClient: fake.CreateHTTPClient(func(request *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: http.StatusBadRequest,
+						Body:       io.NopCloser(bytes.NewReader([]byte(`{"error":"invalid_client","client_secret":"test-client-secret"}`))),
+					}, nil
+				}),
+			},
+			wantErr: errors.New(`unexpected status code 400: {"error":"invalid_client","client_secret":"[REDACTED]"}`), //nolint:goerr113 // used one time only in tests.
+		},
 		{
 			name: "should return error when response body is nil",
 			fields: fields{
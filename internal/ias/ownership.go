@@ -0,0 +1,141 @@
+package ias
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api"
+)
+
+const (
+	// ManagedBy is the value of the managed-by ownership attribute of all applications created by the operator.
+	ManagedBy = "eventing-auth-manager"
+
+	ownershipKeyManagedBy    = "managed-by"
+	ownershipKeyRuntimeID    = "runtime-id"
+	ownershipKeyKcpNamespace = "kcp-namespace"
+	ownershipKeyInstanceID   = "instance-id"
+	ownershipKeyReleased     = "released"
+)
+
+// Ownership identifies the managed runtime and the operator instance an application is created for. Since the IAS applications
+// don't support custom attributes, the ownership is recorded in the description of the application as "key: value" lines.
+type Ownership struct {
+	RuntimeID    string
+	KcpNamespace string
+	InstanceID   string
+	// ApplicationID is the ID of the application recorded in the status of the EventingAuth CR. It is not part of the recorded
+	// ownership, but allows to adopt applications that were created before the ownership was recorded.
+	ApplicationID string
+	// Released is recorded when the EventingAuth CR is deleted with the Orphan deletion policy. A released application is never
+	// collected as orphan and can be adopted by any operator instance managing the same runtime.
+	Released bool
+}
+
+// OperatorIdentity returns the identity of the operator instance with the given ID. It is sent as the user on whose behalf the
+// applications are modified and recorded as actor in the audit log.
+func OperatorIdentity(instanceID string) string {
+	if instanceID == "" {
+		return ManagedBy
+	}
+	return ManagedBy + "/" + instanceID
+}
+
+// describe returns the given description extended by the ownership attributes.
+func (o Ownership) describe(description *string) string {
+	var b strings.Builder
+	if description != nil && *description != "" {
+		b.WriteString(*description)
+		b.WriteString("\n\n")
+	}
+	fmt.Fprintf(&b, "%s: %s\n", ownershipKeyManagedBy, ManagedBy)
+	fmt.Fprintf(&b, "%s: %s\n", ownershipKeyRuntimeID, o.RuntimeID)
+	fmt.Fprintf(&b, "%s: %s\n", ownershipKeyKcpNamespace, o.KcpNamespace)
+	fmt.Fprintf(&b, "%s: %s", ownershipKeyInstanceID, o.InstanceID)
+	if o.Released {
+		fmt.Fprintf(&b, "\n%s: %t", ownershipKeyReleased, o.Released)
+	}
+	return b.String()
+}
+
+// released returns the description of the application with the recorded ownership marked as released. The description without the
+// ownership attributes is kept.
+func released(description *string) *string {
+	recorded := parseOwnership(description)
+	if recorded == nil {
+		return description
+	}
+	recorded.Released = true
+	var kept []string
+	for _, line := range strings.Split(*description, "\n") {
+		key, _, found := strings.Cut(line, ":")
+		if found && isOwnershipKey(strings.TrimSpace(key)) {
+			continue
+		}
+		kept = append(kept, line)
+	}
+	withoutOwnership := strings.TrimSpace(strings.Join(kept, "\n"))
+	return ptr.To(recorded.describe(&withoutOwnership))
+}
+
+func isOwnershipKey(key string) bool {
+	switch key {
+	case ownershipKeyManagedBy, ownershipKeyRuntimeID, ownershipKeyKcpNamespace, ownershipKeyInstanceID, ownershipKeyReleased:
+		return true
+	}
+	return false
+}
+
+// owns returns true if the ownership recorded in the application matches or, if the application has no recorded ownership, if the
+// application ID matches the ID recorded in the status of the EventingAuth CR. A released application is owned by the ownership of
+// the same runtime, regardless of the KCP namespace and operator instance, so that it can be adopted.
+func (o Ownership) owns(app api.ApplicationResponse) bool {
+	recorded := parseOwnership(app.Description)
+	if recorded == nil {
+		return o.ApplicationID != "" && app.Id != nil && app.Id.String() == o.ApplicationID
+	}
+	if recorded.Released {
+		return recorded.RuntimeID == o.RuntimeID
+	}
+	return recorded.RuntimeID == o.RuntimeID && recorded.KcpNamespace == o.KcpNamespace && recorded.InstanceID == o.InstanceID
+}
+
+// parseOwnership returns the ownership recorded in the description of an application or nil if the application is not managed by
+// the operator.
+func parseOwnership(description *string) *Ownership {
+	if description == nil {
+		return nil
+	}
+
+	attributes := map[string]string{}
+	scanner := bufio.NewScanner(strings.NewReader(*description))
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), ":")
+		if found {
+			attributes[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+	if attributes[ownershipKeyManagedBy] != ManagedBy {
+		return nil
+	}
+	return &Ownership{
+		RuntimeID:    attributes[ownershipKeyRuntimeID],
+		KcpNamespace: attributes[ownershipKeyKcpNamespace],
+		InstanceID:   attributes[ownershipKeyInstanceID],
+		Released:     attributes[ownershipKeyReleased] == "true",
+	}
+}
+
+// filterOwned returns the applications owned by the given ownership.
+func filterOwned(apps []api.ApplicationResponse, owner Ownership) []api.ApplicationResponse {
+	owned := make([]api.ApplicationResponse, 0, len(apps))
+	for _, app := range apps {
+		if owner.owns(app) {
+			owned = append(owned, app)
+		}
+	}
+	return owned
+}
@@ -0,0 +1,101 @@
+package ias
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api"
+)
+
+func Test_Ownership_owns(t *testing.T) {
+	appID := uuid.MustParse("90764f89-f041-4ccf-8da9-7a7c2d60d7fc")
+
+	tests := []struct {
+		name      string
+		givenApp  api.ApplicationResponse
+		givenID   string
+		wantOwned bool
+	}{
+		{
+			name:      "should own application with matching ownership",
+			givenApp:  api.ApplicationResponse{Id: &appID, Description: ptr.To(testOwner.describe(ptr.To("rendered description")))},
+			wantOwned: true,
+		},
+		{
+			name: "should not own application of another operator instance",
+			givenApp: api.ApplicationResponse{Id: &appID, Description: ptr.To(Ownership{
+				RuntimeID:    testOwner.RuntimeID,
+				KcpNamespace: testOwner.KcpNamespace,
+				InstanceID:   "other-instance",
+			}.describe(nil))},
+			givenID: appID.String(),
+		},
+		{
+			name: "should own released application of another operator instance",
+			givenApp: api.ApplicationResponse{Id: &appID, Description: ptr.To(Ownership{
+				RuntimeID:    testOwner.RuntimeID,
+				KcpNamespace: "other-namespace",
+				InstanceID:   "other-instance",
+				Released:     true,
+			}.describe(nil))},
+			wantOwned: true,
+		},
+		{
+			name: "should not own released application of another runtime",
+			givenApp: api.ApplicationResponse{Id: &appID, Description: ptr.To(Ownership{
+				RuntimeID: "other-runtime",
+				Released:  true,
+			}.describe(nil))},
+		},
+		{
+			name:     "should not own application without ownership",
+			givenApp: api.ApplicationResponse{Id: &appID, Description: ptr.To("foreign application")},
+		},
+		{
+			name:      "should own application without ownership if the ID matches the ID of the status",
+			givenApp:  api.ApplicationResponse{Id: &appID},
+			givenID:   appID.String(),
+			wantOwned: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			owner := testOwner
+			owner.ApplicationID = tt.givenID
+
+			// when
+			owned := owner.owns(tt.givenApp)
+
+			// then
+			require.Equal(t, tt.wantOwned, owned)
+		})
+	}
+}
+
+func Test_parseOwnership(t *testing.T) {
+	require.Nil(t, parseOwnership(nil))
+	require.Nil(t, parseOwnership(ptr.To("runtime-id: test")))
+	require.Equal(t, &Ownership{RuntimeID: "runtime", KcpNamespace: "kcp-system", InstanceID: "instance"},
+		parseOwnership(ptr.To(Ownership{RuntimeID: "runtime", KcpNamespace: "kcp-system", InstanceID: "instance"}.describe(ptr.To("description")))))
+}
+
+func Test_released(t *testing.T) {
+	// given
+	description := testOwner.describe(ptr.To("rendered description"))
+
+	// when
+	releasedDescription := released(&description)
+
+	// then
+	wantOwner := testOwner
+	wantOwner.Released = true
+	require.Equal(t, wantOwner.describe(ptr.To("rendered description")), *releasedDescription)
+	require.Equal(t, &wantOwner, parseOwnership(releasedDescription))
+	require.Equal(t, releasedDescription, released(releasedDescription))
+	require.Equal(t, ptr.To("foreign application"), released(ptr.To("foreign application")))
+}
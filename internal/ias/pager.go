@@ -0,0 +1,103 @@
+package ias
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api"
+	"github.com/kyma-project/eventing-auth-manager/internal/logging"
+	"github.com/kyma-project/eventing-auth-manager/internal/redact"
+)
+
+var errCursorNotAdvanced = errors.New("cursor of the next page of applications did not advance")
+
+// applicationPager iterates over the pages of the applications matching a filter by following the cursor returned with each page.
+type applicationPager struct {
+	api    api.ClientWithResponsesInterface
+	filter *string
+	cursor *uuid.UUID
+	done   bool
+	// totalResults is the total number of applications matching the filter as reported with the last fetched page.
+	totalResults int
+}
+
+// newApplicationPager returns a pager over the applications matching the filter. An empty filter matches all applications.
+func newApplicationPager(apiClient api.ClientWithResponsesInterface, filter Filter) *applicationPager {
+	p := &applicationPager{api: apiClient}
+	if !filter.IsEmpty() {
+		expression := filter.String()
+		p.filter = &expression
+	}
+	return p
+}
+
+// HasNext returns true if there is another page of applications to fetch.
+func (p *applicationPager) HasNext() bool {
+	return !p.done
+}
+
+// Next fetches the next page of applications. It stops with the error of the context if the context is cancelled.
+func (p *applicationPager) Next(ctx context.Context) ([]api.ApplicationResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	res, err := p.api.GetAllApplicationsWithResponse(ctx, &api.GetAllApplicationsParams{Filter: p.filter, Cursor: p.cursor})
+	if err != nil {
+		return nil, err
+	}
+
+	// This is not documented in the API, but the actual API returned 404 if no applications were found.
+	if res.StatusCode() == http.StatusNotFound {
+		p.done = true
+		return []api.ApplicationResponse{}, nil
+	}
+	if res.StatusCode() != http.StatusOK {
+		logging.FromContext(ctx, logging.ComponentIAS).Error(err, "Failed to fetch page of applications", "filter", p.filter, "cursor", p.cursor, "statusCode", res.StatusCode(), "body", redact.Body(res.Body))
+		return nil, classifyStatusCode(errListApplications, res.StatusCode())
+	}
+
+	page := res.JSON200
+	if page.TotalResults != nil {
+		p.totalResults = int(*page.TotalResults)
+	}
+	apps := []api.ApplicationResponse{}
+	if page.Applications != nil {
+		apps = *page.Applications
+	}
+
+	if page.NextCursor == nil || *page.NextCursor == "" {
+		p.done = true
+		return apps, nil
+	}
+	cursor, err := uuid.Parse(*page.NextCursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid cursor of the next page of applications")
+	}
+	// An empty page or a repeated cursor would otherwise cause an endless loop.
+	if len(apps) == 0 || (p.cursor != nil && *p.cursor == cursor) {
+		return nil, errCursorNotAdvanced
+	}
+	p.cursor = &cursor
+	return apps, nil
+}
+
+// listApplications fetches all pages of the applications matching the filter.
+func listApplications(ctx context.Context, apiClient api.ClientWithResponsesInterface, filter Filter) ([]api.ApplicationResponse, error) {
+	pager := newApplicationPager(apiClient, filter)
+	apps := make([]api.ApplicationResponse, 0)
+	for pager.HasNext() {
+		page, err := pager.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(apps) == 0 && pager.totalResults > 0 {
+			apps = make([]api.ApplicationResponse, 0, pager.totalResults)
+		}
+		apps = append(apps, page...)
+	}
+	return apps, nil
+}
@@ -0,0 +1,121 @@
+package ias
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api"
+	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api/mocks"
+)
+
+func Test_listApplications(t *testing.T) {
+	firstAppID := uuid.MustParse("90764f89-f041-4ccf-8da9-7a7c2d60d7fc")
+	secondAppID := uuid.MustParse("41de6fec-e0fc-47d7-b35c-3b19c4927e4f")
+	cursor := uuid.MustParse("5ab797c6-7c43-4d3d-9d4f-2b2ac5e1b0a5")
+	filter := `name eq Test-App-Name`
+
+	tests := []struct {
+		name         string
+		givenContext func() context.Context
+		givenAPIMock func() *mocks.ClientWithResponsesInterface
+		wantAppIDs   []uuid.UUID
+		wantError    error
+	}{
+		{
+			name: "should follow the cursor and keep the filter on all pages",
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAllApplicationsPage(&clientMock, &api.GetAllApplicationsParams{Filter: &filter}, ptr.To(cursor.String()),
+					api.ApplicationResponse{Id: &firstAppID})
+				mockGetAllApplicationsPage(&clientMock, &api.GetAllApplicationsParams{Filter: &filter, Cursor: &cursor}, nil,
+					api.ApplicationResponse{Id: &secondAppID})
+				return &clientMock
+			},
+			wantAppIDs: []uuid.UUID{firstAppID, secondAppID},
+		},
+		{
+			name: "should return error when the cursor does not advance",
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAllApplicationsPage(&clientMock, &api.GetAllApplicationsParams{Filter: &filter}, ptr.To(cursor.String()),
+					api.ApplicationResponse{Id: &firstAppID})
+				mockGetAllApplicationsPage(&clientMock, &api.GetAllApplicationsParams{Filter: &filter, Cursor: &cursor}, ptr.To(cursor.String()),
+					api.ApplicationResponse{Id: &secondAppID})
+				return &clientMock
+			},
+			wantError: errCursorNotAdvanced,
+		},
+		{
+			name: "should stop when the context is cancelled",
+			givenContext: func() context.Context {
+				ctx, cancel := context.WithCancel(context.TODO())
+				cancel()
+				return ctx
+			},
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				return &mocks.ClientWithResponsesInterface{}
+			},
+			wantError: context.Canceled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ctx := context.TODO()
+			if tt.givenContext != nil {
+				ctx = tt.givenContext()
+			}
+			apiMock := tt.givenAPIMock()
+
+			// when
+			filter, err := FilterByName("Test-App-Name")
+			require.NoError(t, err)
+			apps, err := listApplications(ctx, apiMock, filter)
+
+			// then
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+			} else {
+				require.NoError(t, err)
+				appIDs := make([]uuid.UUID, 0, len(apps))
+				for _, app := range apps {
+					appIDs = append(appIDs, *app.Id)
+				}
+				require.Equal(t, tt.wantAppIDs, appIDs)
+			}
+
+			apiMock.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_getApplicationByName_DuplicatesOnDifferentPages(t *testing.T) {
+	// given
+	firstAppID := uuid.MustParse("90764f89-f041-4ccf-8da9-7a7c2d60d7fc")
+	secondAppID := uuid.MustParse("41de6fec-e0fc-47d7-b35c-3b19c4927e4f")
+	cursor := uuid.MustParse("5ab797c6-7c43-4d3d-9d4f-2b2ac5e1b0a5")
+	filter := `name eq Test-App-Name`
+
+	apiMock := &mocks.ClientWithResponsesInterface{}
+	mockGetAllApplicationsPage(apiMock, &api.GetAllApplicationsParams{Filter: &filter}, ptr.To(cursor.String()),
+		api.ApplicationResponse{Id: &firstAppID, Description: ptr.To(testOwner.describe(nil))})
+	mockGetAllApplicationsPage(apiMock, &api.GetAllApplicationsParams{Filter: &filter, Cursor: &cursor}, nil,
+		api.ApplicationResponse{Id: &secondAppID, Description: ptr.To(testOwner.describe(nil))})
+	client := client{
+		api: apiMock,
+	}
+
+	// when
+	_, err := client.getApplicationByName(context.TODO(), "Test-App-Name", testOwner)
+
+	// then
+	var duplicatesErr *DuplicateApplicationsError
+	require.ErrorAs(t, err, &duplicatesErr)
+	require.Equal(t, []string{firstAppID.String(), secondAppID.String()}, duplicatesErr.IDs)
+	apiMock.AssertExpectations(t)
+}
@@ -0,0 +1,290 @@
+package ias
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api"
+)
+
+// authenticationSchemaKey is the attribute of the application with the authentication schema, whose attributes are patched separately.
+const authenticationSchemaKey = string(api.SchemasEnumUrnSapIdentityApplicationSchemasExtensionSci10Authentication)
+
+// patchPointerEscaper escapes the segments of a JSON pointer path.
+var patchPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1") //nolint:gochecknoglobals // Immutable replacer.
+
+// applicationPatch is the body of a patch request. It is used instead of api.ApplicationPatch, since the value of an api.PatchOperation
+// can only carry objects, while each operation sets a single attribute, which can also be a scalar or an array.
+type applicationPatch struct {
+	Operations []patchOperation `json:"operations"`
+}
+
+type patchOperation struct {
+	Op    api.PatchOperationOp `json:"op"`
+	Path  string               `json:"path"`
+	Value interface{}          `json:"value"`
+}
+
+// MarshalJSON omits the value of remove operations only, since an empty value, like an empty array or string, is a valid value of add
+// and replace operations.
+func (o patchOperation) MarshalJSON() ([]byte, error) {
+	if o.Op == api.Remove {
+		return json.Marshal(struct {
+			Op   api.PatchOperationOp `json:"op"`
+			Path string               `json:"path"`
+		}{Op: o.Op, Path: o.Path})
+	}
+	type operation patchOperation
+	return json.Marshal(operation(o))
+}
+
+// newApplicationPatch compares the settings of the desired application that are managed by the application template with the
+// actual application and returns the patch operations required to update the actual application. Settings that are not set in the
+// desired application are not managed and therefore not compared. Each operation sets a single attribute, so that all other attributes
+// of the application, like the client ID or the API secrets, are left unchanged.
+func newApplicationPatch(desired api.Application, actual api.ApplicationResponse) (applicationPatch, error) {
+	document, err := toPatchValue(actual)
+	if err != nil {
+		return applicationPatch{}, err
+	}
+	actualDocument, _ := document.(map[string]interface{})
+	patch := applicationPatch{Operations: make([]patchOperation, 0)}
+	set := func(value interface{}, path ...string) error {
+		converted, err := toPatchValue(value)
+		if err != nil {
+			return err
+		}
+		if isEmptyPatchValue(converted) {
+			// an empty value clears the attribute, which is only required if the attribute exists
+			if attributeExists(actualDocument, path) {
+				patch.Operations = append(patch.Operations, patchOperation{Op: api.Remove, Path: patchPath(path)})
+			}
+			return nil
+		}
+		patch.Operations = append(patch.Operations, newSetOperation(actualDocument, path, converted))
+		return nil
+	}
+
+	if desired.Description != nil && !reflect.DeepEqual(desired.Description, actual.Description) {
+		if err := set(*desired.Description, "description"); err != nil {
+			return applicationPatch{}, err
+		}
+	}
+	if desired.ParentApplicationId != nil && !reflect.DeepEqual(desired.ParentApplicationId, actual.ParentApplicationId) {
+		if err := set(desired.ParentApplicationId.String(), "parentApplicationId"); err != nil {
+			return applicationPatch{}, err
+		}
+	}
+
+	for _, change := range authenticationSchemaChanges(
+		desired.UrnSapIdentityApplicationSchemasExtensionSci10Authentication,
+		actual.UrnSapIdentityApplicationSchemasExtensionSci10Authentication,
+	) {
+		if err := set(change.value, append([]string{authenticationSchemaKey}, change.path...)...); err != nil {
+			return applicationPatch{}, err
+		}
+	}
+
+	return patch, nil
+}
+
+// attributeChange is the desired value of the attribute of the authentication schema at the path.
+type attributeChange struct {
+	path  []string
+	value interface{}
+}
+
+func authenticationSchemaChanges(desired, actual *api.AuthenticationSchema) []attributeChange {
+	var changes []attributeChange
+	if desired == nil {
+		return changes
+	}
+	if actual == nil {
+		actual = &api.AuthenticationSchema{}
+	}
+
+	changes = append(changes, oidcConfigurationChanges(desired.OpenIdConnectConfiguration, actual.OpenIdConnectConfiguration)...)
+	if desired.ProvidedApis != nil && !reflect.DeepEqual(desired.ProvidedApis, actual.ProvidedApis) {
+		changes = append(changes, attributeChange{path: []string{"providedApis"}, value: desired.ProvidedApis})
+	}
+	if desired.ConsumedApis != nil && !consumedApisEqual(*desired.ConsumedApis, actual.ConsumedApis) {
+		changes = append(changes, attributeChange{path: []string{"consumedApis"}, value: desired.ConsumedApis})
+	}
+	if desired.AssertionAttributes != nil && !reflect.DeepEqual(desired.AssertionAttributes, actual.AssertionAttributes) {
+		changes = append(changes, attributeChange{path: []string{"assertionAttributes"}, value: desired.AssertionAttributes})
+	}
+	if desired.AdvancedAssertionAttributes != nil && !reflect.DeepEqual(desired.AdvancedAssertionAttributes, actual.AdvancedAssertionAttributes) {
+		changes = append(changes, attributeChange{path: []string{"advancedAssertionAttributes"}, value: desired.AdvancedAssertionAttributes})
+	}
+	return changes
+}
+
+func oidcConfigurationChanges(desired, actual *api.OIDCConfiguration) []attributeChange {
+	var changes []attributeChange
+	if desired == nil {
+		return changes
+	}
+	if actual == nil {
+		actual = &api.OIDCConfiguration{}
+	}
+	if desired.RestrictedGrantTypes != nil && !reflect.DeepEqual(desired.RestrictedGrantTypes, actual.RestrictedGrantTypes) {
+		changes = append(changes, attributeChange{
+			path:  []string{"openIdConnectConfiguration", "restrictedGrantTypes"},
+			value: desired.RestrictedGrantTypes,
+		})
+	}
+	if desired.TokenPolicy != nil && desired.TokenPolicy.JwtValidity != nil {
+		if actual.TokenPolicy == nil || !reflect.DeepEqual(desired.TokenPolicy.JwtValidity, actual.TokenPolicy.JwtValidity) {
+			changes = append(changes, attributeChange{
+				path:  []string{"openIdConnectConfiguration", "tokenPolicy", "jwtValidity"},
+				value: desired.TokenPolicy.JwtValidity,
+			})
+		}
+	}
+	return changes
+}
+
+// consumedApisEqual compares the consumed APIs, but ignores the client ID if it is not set in the desired API, because IAS fills it.
+func consumedApisEqual(desired []api.ConsumedApi, actual *[]api.ConsumedApi) bool {
+	if actual == nil || len(desired) != len(*actual) {
+		return false
+	}
+	for i, d := range desired {
+		a := (*actual)[i]
+		if d.Name != a.Name || d.ApiName != a.ApiName || d.AppId != a.AppId {
+			return false
+		}
+		if d.ClientId != nil && !reflect.DeepEqual(d.ClientId, a.ClientId) {
+			return false
+		}
+	}
+	return true
+}
+
+// newAddCertificatePatch returns the patch that registers the client certificate on the application in addition to the registered
+// client certificates.
+func newAddCertificatePatch(actual api.ApplicationResponse, certificate clientCertificate) (applicationPatch, error) {
+	value, err := toPatchValue(newCertificateData(certificate))
+	if err != nil {
+		return applicationPatch{}, err
+	}
+	if schema := actual.UrnSapIdentityApplicationSchemasExtensionSci10Authentication; schema != nil && schema.ApiCertificates != nil {
+		// "-" appends the value to the existing array
+		return applicationPatch{Operations: []patchOperation{{Op: api.Add, Path: apiCertificatesPath() + "/-", Value: value}}}, nil
+	}
+
+	document, err := toPatchValue(actual)
+	if err != nil {
+		return applicationPatch{}, err
+	}
+	actualDocument, _ := document.(map[string]interface{})
+	return applicationPatch{Operations: []patchOperation{
+		newSetOperation(actualDocument, []string{authenticationSchemaKey, "apiCertificates"}, []interface{}{value}),
+	}}, nil
+}
+
+// newRemoveCertificatesPatch returns the patch that removes the client certificates registered by the operator from the application,
+// except the certificate with the given base64 encoded DER. The certificates are removed starting with the last one, so that the
+// indices of the following operations stay valid.
+func newRemoveCertificatesPatch(actual api.ApplicationResponse, keepBase64DER string) applicationPatch {
+	patch := applicationPatch{Operations: make([]patchOperation, 0)}
+	schema := actual.UrnSapIdentityApplicationSchemasExtensionSci10Authentication
+	if schema == nil || schema.ApiCertificates == nil {
+		return patch
+	}
+	description := ptr.Deref(newCertificateData(clientCertificate{}).Description, "")
+	certificates := *schema.ApiCertificates
+	for i := len(certificates) - 1; i >= 0; i-- {
+		if certificates[i].Base64Certificate == keepBase64DER || ptr.Deref(certificates[i].Description, "") != description {
+			continue
+		}
+		patch.Operations = append(patch.Operations, patchOperation{Op: api.Remove, Path: fmt.Sprintf("%s/%d", apiCertificatesPath(), i)})
+	}
+	return patch
+}
+
+func apiCertificatesPath() string {
+	return patchPath([]string{authenticationSchemaKey, "apiCertificates"})
+}
+
+func toPatchValue(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal patch value")
+	}
+	var value interface{}
+	if err := json.Unmarshal(b, &value); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal patch value")
+	}
+	return value, nil
+}
+
+// newSetOperation returns the operation that sets the attribute at the path of the document to the value. An existing attribute is
+// replaced. Otherwise, the first missing object on the path is added with the value nested in it, since the parent of the target of an
+// operation must exist.
+func newSetOperation(document map[string]interface{}, path []string, value interface{}) patchOperation {
+	op := api.Replace
+	i := 0
+	for ; i < len(path)-1; i++ {
+		child, isObject := document[path[i]].(map[string]interface{})
+		if !isObject {
+			break
+		}
+		document = child
+	}
+	if _, found := document[path[i]]; !found {
+		op = api.Add
+	}
+	for j := len(path) - 1; j > i; j-- {
+		value = map[string]interface{}{path[j]: value}
+	}
+
+	return patchOperation{Op: op, Path: patchPath(path[:i+1]), Value: value}
+}
+
+// patchPath returns the JSON pointer of the attribute at the path.
+func patchPath(path []string) string {
+	segments := make([]string, 0, len(path))
+	for _, segment := range path {
+		segments = append(segments, patchPointerEscaper.Replace(segment))
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+// attributeExists returns true if the document contains the attribute at the path.
+func attributeExists(document map[string]interface{}, path []string) bool {
+	for i, segment := range path {
+		value, found := document[segment]
+		if !found {
+			return false
+		}
+		if i == len(path)-1 {
+			return true
+		}
+		child, isObject := value.(map[string]interface{})
+		if !isObject {
+			return false
+		}
+		document = child
+	}
+	return false
+}
+
+// isEmptyPatchValue returns true for the values that clear an attribute: null, an empty string and an empty array.
+func isEmptyPatchValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
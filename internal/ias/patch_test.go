@@ -0,0 +1,293 @@
+package ias
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api"
+)
+
+func Test_newApplicationPatch(t *testing.T) {
+	appID := uuid.MustParse("41de6fec-e0fc-47d7-b35c-3b19c4927e4f")
+
+	tests := []struct {
+		name          string
+		givenTemplate ApplicationTemplate
+		givenActual   api.ApplicationResponse
+		wantPatch     applicationPatch
+	}{
+		{
+			name:          "should not patch when template is empty and ownership is recorded",
+			givenTemplate: ApplicationTemplate{},
+			givenActual:   api.ApplicationResponse{Description: ptr.To(testOwner.describe(nil))},
+			wantPatch:     applicationPatch{Operations: []patchOperation{}},
+		},
+		{
+			name:          "should record ownership in the description of an adopted application",
+			givenTemplate: ApplicationTemplate{},
+			givenActual:   api.ApplicationResponse{},
+			wantPatch: applicationPatch{Operations: []patchOperation{
+				{Op: api.Add, Path: "/description", Value: testOwner.describe(nil)},
+			}},
+		},
+		{
+			name: "should not patch when managed settings are equal",
+			givenTemplate: ApplicationTemplate{
+				Description:  ptr.To("test"),
+				JwtValidity:  ptr.To(600),
+				ConsumedAPIs: []ConsumedAPI{{Name: "eventing", APIName: "publish", AppID: appID.String()}},
+			},
+			givenActual: api.ApplicationResponse{
+				Description: ptr.To(testOwner.describe(ptr.To("test"))),
+				UrnSapIdentityApplicationSchemasExtensionSci10Authentication: &api.AuthenticationSchema{
+					ClientId: ptr.To("unmanaged"),
+					OpenIdConnectConfiguration: &api.OIDCConfiguration{
+						TokenPolicy: &api.TokenPolicy{JwtValidity: ptr.To(600), RefreshValidity: ptr.To(1000)},
+					},
+					// the client ID is filled by IAS and must be ignored
+					ConsumedApis: &[]api.ConsumedApi{{Name: "eventing", ApiName: "publish", AppId: appID, ClientId: ptr.To("filled")}},
+				},
+			},
+			wantPatch: applicationPatch{Operations: []patchOperation{}},
+		},
+		{
+			name: "should patch only changed settings",
+			givenTemplate: ApplicationTemplate{
+				Description:  ptr.To("new"),
+				JwtValidity:  ptr.To(600),
+				ProvidedAPIs: []ProvidedAPI{{Name: "publish"}},
+			},
+			givenActual: api.ApplicationResponse{
+				Description: ptr.To("old"),
+				UrnSapIdentityApplicationSchemasExtensionSci10Authentication: &api.AuthenticationSchema{
+					OpenIdConnectConfiguration: &api.OIDCConfiguration{
+						TokenPolicy: &api.TokenPolicy{JwtValidity: ptr.To(600)},
+					},
+				},
+			},
+			wantPatch: applicationPatch{Operations: []patchOperation{
+				{Op: api.Replace, Path: "/description", Value: testOwner.describe(ptr.To("new"))},
+				{
+					Op:    api.Add,
+					Path:  "/urn:sap:identity:application:schemas:extension:sci:1.0:Authentication/providedApis",
+					Value: []interface{}{map[string]interface{}{"name": "publish"}},
+				},
+			}},
+		},
+		{
+			name:          "should patch token policy when jwt validity changed",
+			givenTemplate: ApplicationTemplate{JwtValidity: ptr.To(600)},
+			givenActual:   api.ApplicationResponse{Description: ptr.To(testOwner.describe(nil))},
+			wantPatch: applicationPatch{Operations: []patchOperation{
+				{
+					Op:   api.Add,
+					Path: "/urn:sap:identity:application:schemas:extension:sci:1.0:Authentication",
+					Value: map[string]interface{}{
+						"openIdConnectConfiguration": map[string]interface{}{
+							"tokenPolicy": map[string]interface{}{"jwtValidity": float64(600)},
+						},
+					},
+				},
+			}},
+		},
+		{
+			name:          "should replace only jwt validity of existing token policy",
+			givenTemplate: ApplicationTemplate{JwtValidity: ptr.To(600)},
+			givenActual: api.ApplicationResponse{
+				Description: ptr.To(testOwner.describe(nil)),
+				UrnSapIdentityApplicationSchemasExtensionSci10Authentication: &api.AuthenticationSchema{
+					ClientId: ptr.To("unmanaged"),
+					OpenIdConnectConfiguration: &api.OIDCConfiguration{
+						RedirectUris: &[]string{"https://redirect.com"},
+						TokenPolicy:  &api.TokenPolicy{JwtValidity: ptr.To(3600), RefreshValidity: ptr.To(1000)},
+					},
+				},
+			},
+			wantPatch: applicationPatch{Operations: []patchOperation{
+				{
+					Op:    api.Replace,
+					Path:  "/urn:sap:identity:application:schemas:extension:sci:1.0:Authentication/openIdConnectConfiguration/tokenPolicy/jwtValidity",
+					Value: float64(600),
+				},
+			}},
+		},
+		{
+			name:          "should add token policy to existing OIDC configuration",
+			givenTemplate: ApplicationTemplate{JwtValidity: ptr.To(600)},
+			givenActual: api.ApplicationResponse{
+				Description: ptr.To(testOwner.describe(nil)),
+				UrnSapIdentityApplicationSchemasExtensionSci10Authentication: &api.AuthenticationSchema{
+					OpenIdConnectConfiguration: &api.OIDCConfiguration{RedirectUris: &[]string{"https://redirect.com"}},
+				},
+			},
+			wantPatch: applicationPatch{Operations: []patchOperation{
+				{
+					Op:    api.Add,
+					Path:  "/urn:sap:identity:application:schemas:extension:sci:1.0:Authentication/openIdConnectConfiguration/tokenPolicy",
+					Value: map[string]interface{}{"jwtValidity": float64(600)},
+				},
+			}},
+		},
+		{
+			name:          "should remove restricted grant types cleared by the template",
+			givenTemplate: ApplicationTemplate{RestrictedGrantTypes: []string{}},
+			givenActual: api.ApplicationResponse{
+				Description: ptr.To(testOwner.describe(nil)),
+				UrnSapIdentityApplicationSchemasExtensionSci10Authentication: &api.AuthenticationSchema{
+					OpenIdConnectConfiguration: &api.OIDCConfiguration{RestrictedGrantTypes: &[]api.GrantType{"clientCredentials"}},
+				},
+			},
+			wantPatch: applicationPatch{Operations: []patchOperation{
+				{
+					Op:   api.Remove,
+					Path: "/urn:sap:identity:application:schemas:extension:sci:1.0:Authentication/openIdConnectConfiguration/restrictedGrantTypes",
+				},
+			}},
+		},
+		{
+			name:          "should not patch restricted grant types cleared by the template that are not set",
+			givenTemplate: ApplicationTemplate{RestrictedGrantTypes: []string{}},
+			givenActual: api.ApplicationResponse{
+				Description: ptr.To(testOwner.describe(nil)),
+				UrnSapIdentityApplicationSchemasExtensionSci10Authentication: &api.AuthenticationSchema{
+					OpenIdConnectConfiguration: &api.OIDCConfiguration{RedirectUris: &[]string{"https://redirect.com"}},
+				},
+			},
+			wantPatch: applicationPatch{Operations: []patchOperation{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			desired, err := newIasApplication("Test-App-Name", ApplicationConfig{GlobalAccountID: "GAID", Owner: testOwner, Template: tt.givenTemplate})
+			require.NoError(t, err)
+
+			// when
+			patch, err := newApplicationPatch(desired, tt.givenActual)
+
+			// then
+			require.NoError(t, err)
+			require.Equal(t, tt.wantPatch, patch)
+		})
+	}
+}
+
+func Test_patchOperation_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name           string
+		givenOperation patchOperation
+		wantJSON       string
+	}{
+		{
+			name:           "should keep empty array of replace operation",
+			givenOperation: patchOperation{Op: api.Replace, Path: "/grantTypes", Value: []interface{}{}},
+			wantJSON:       `{"op":"replace","path":"/grantTypes","value":[]}`,
+		},
+		{
+			name:           "should keep empty string of add operation",
+			givenOperation: patchOperation{Op: api.Add, Path: "/description", Value: ""},
+			wantJSON:       `{"op":"add","path":"/description","value":""}`,
+		},
+		{
+			name:           "should omit value of remove operation",
+			givenOperation: patchOperation{Op: api.Remove, Path: "/grantTypes"},
+			wantJSON:       `{"op":"remove","path":"/grantTypes"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			b, err := json.Marshal(tt.givenOperation)
+
+			// then
+			require.NoError(t, err)
+			require.JSONEq(t, tt.wantJSON, string(b))
+		})
+	}
+}
+
+func Test_newAddCertificatePatch(t *testing.T) {
+	certificate := clientCertificate{base64DER: "new-cert", dn: "CN=Test-App-Name"}
+	certificateValue := map[string]interface{}{
+		"authorizationScopes": []interface{}{"oAuth"},
+		"base64Certificate":   "new-cert",
+		"description":         "eventing-auth-manager",
+		"dn":                  "CN=Test-App-Name",
+	}
+
+	tests := []struct {
+		name        string
+		givenActual api.ApplicationResponse
+		wantPatch   applicationPatch
+	}{
+		{
+			name: "should append certificate to registered certificates",
+			givenActual: api.ApplicationResponse{
+				UrnSapIdentityApplicationSchemasExtensionSci10Authentication: &api.AuthenticationSchema{
+					ApiCertificates: &[]api.ApiCertificateData{{Base64Certificate: "old-cert"}},
+				},
+			},
+			wantPatch: applicationPatch{Operations: []patchOperation{
+				{
+					Op:    api.Add,
+					Path:  "/urn:sap:identity:application:schemas:extension:sci:1.0:Authentication/apiCertificates/-",
+					Value: certificateValue,
+				},
+			}},
+		},
+		{
+			name: "should add certificates to application without certificates",
+			givenActual: api.ApplicationResponse{
+				UrnSapIdentityApplicationSchemasExtensionSci10Authentication: &api.AuthenticationSchema{
+					ClientId: ptr.To("client-id"),
+				},
+			},
+			wantPatch: applicationPatch{Operations: []patchOperation{
+				{
+					Op:    api.Add,
+					Path:  "/urn:sap:identity:application:schemas:extension:sci:1.0:Authentication/apiCertificates",
+					Value: []interface{}{certificateValue},
+				},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			patch, err := newAddCertificatePatch(tt.givenActual, certificate)
+
+			// then
+			require.NoError(t, err)
+			require.Equal(t, tt.wantPatch, patch)
+		})
+	}
+}
+
+func Test_newRemoveCertificatesPatch(t *testing.T) {
+	// given
+	actual := api.ApplicationResponse{
+		UrnSapIdentityApplicationSchemasExtensionSci10Authentication: &api.AuthenticationSchema{
+			ApiCertificates: &[]api.ApiCertificateData{
+				{Base64Certificate: "old-cert", Description: ptr.To("eventing-auth-manager")},
+				{Base64Certificate: "foreign-cert", Description: ptr.To("registered manually")},
+				{Base64Certificate: "new-cert", Description: ptr.To("eventing-auth-manager")},
+				{Base64Certificate: "older-cert", Description: ptr.To("eventing-auth-manager")},
+			},
+		},
+	}
+
+	// when
+	patch := newRemoveCertificatesPatch(actual, "new-cert")
+
+	// then
+	require.Equal(t, applicationPatch{Operations: []patchOperation{
+		{Op: api.Remove, Path: "/urn:sap:identity:application:schemas:extension:sci:1.0:Authentication/apiCertificates/3"},
+		{Op: api.Remove, Path: "/urn:sap:identity:application:schemas:extension:sci:1.0:Authentication/apiCertificates/0"},
+	}}, patch)
+	require.Empty(t, newRemoveCertificatesPatch(api.ApplicationResponse{}, "new-cert").Operations)
+}
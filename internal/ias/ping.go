@@ -0,0 +1,36 @@
+package ias
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api"
+)
+
+var errPing = errors.New("failed to list applications to verify the connection to IAS")
+
+// Ping verifies that the Applications API of IAS accepts the credentials of the client and that the OIDC configuration of the tenant
+// can be fetched. It requests a single application and bypasses the cached OIDC endpoints, so that it reflects the current
+// availability of IAS.
+func (c *client) Ping(ctx context.Context) error {
+	res, err := c.api.GetAllApplicationsWithResponse(ctx, &api.GetAllApplicationsParams{Limit: ptr.To(int32(1))})
+	if err != nil {
+		return err
+	}
+	// The API returns 404 if no applications exist, which still proves that the credentials are accepted.
+	if res.StatusCode() != http.StatusOK && res.StatusCode() != http.StatusNotFound {
+		return classifyStatusCode(errors.Wrapf(errPing, "status code %d", res.StatusCode()), res.StatusCode())
+	}
+
+	tokenEndpoint, err := c.oidcClient.GetTokenEndpoint(ctx)
+	if err != nil {
+		return classifyOIDCDiscovery(err)
+	}
+	if tokenEndpoint == nil {
+		return classifyOIDCDiscovery(errFetchTokenURL)
+	}
+	return nil
+}
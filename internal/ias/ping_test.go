@@ -0,0 +1,107 @@
+package ias
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api"
+	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api/mocks"
+	eamoidcmocks "github.com/kyma-project/eventing-auth-manager/internal/ias/internal/oidc/mocks"
+)
+
+func Test_Ping(t *testing.T) {
+	errConnectionRefused := errors.New("connection refused") //nolint:goerr113 // used one time only in tests.
+	tests := []struct {
+		name           string
+		givenAPIMock   func() *mocks.ClientWithResponsesInterface
+		givenOIDCMock  func() *eamoidcmocks.Client
+		wantErrorCause error
+	}{
+		{
+			name: "should succeed when applications are listed and OIDC configuration is fetched",
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAllApplicationsWithResponseStatusOkEmptyResponse(&clientMock)
+				return &clientMock
+			},
+			givenOIDCMock: func() *eamoidcmocks.Client {
+				oidcMock := eamoidcmocks.Client{}
+				oidcMock.On("GetTokenEndpoint", mock.Anything).Return(ptr.To("https://test.com/token"), nil)
+				return &oidcMock
+			},
+		},
+		{
+			name: "should succeed when no applications exist",
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAllApplicationsWithResponseStatusNotFound(&clientMock)
+				return &clientMock
+			},
+			givenOIDCMock: func() *eamoidcmocks.Client {
+				oidcMock := eamoidcmocks.Client{}
+				oidcMock.On("GetTokenEndpoint", mock.Anything).Return(ptr.To("https://test.com/token"), nil)
+				return &oidcMock
+			},
+		},
+		{
+			name: "should fail when credentials are rejected",
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				clientMock.On("GetAllApplicationsWithResponse", mock.Anything, mock.Anything).
+					Return(&api.GetAllApplicationsResponse{
+						HTTPResponse: &http.Response{StatusCode: http.StatusUnauthorized},
+					}, nil)
+				return &clientMock
+			},
+			givenOIDCMock: func() *eamoidcmocks.Client {
+				return &eamoidcmocks.Client{}
+			},
+			wantErrorCause: ErrUnauthorized,
+		},
+		{
+			name: "should fail when OIDC configuration can't be fetched",
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAllApplicationsWithResponseStatusOkEmptyResponse(&clientMock)
+				return &clientMock
+			},
+			givenOIDCMock: func() *eamoidcmocks.Client {
+				oidcMock := eamoidcmocks.Client{}
+				oidcMock.On("GetTokenEndpoint", mock.Anything).Return(nil, errConnectionRefused)
+				return &oidcMock
+			},
+			wantErrorCause: ErrOIDCDiscoveryFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			apiMock := tt.givenAPIMock()
+			oidcMock := tt.givenOIDCMock()
+			c := client{
+				api:        apiMock,
+				oidcClient: oidcMock,
+				// the cached token URL must not be used to verify the OIDC configuration
+				tokenURL: ptr.To("https://from-cache.com/token"),
+			}
+
+			// when
+			err := c.Ping(context.TODO())
+
+			// then
+			if tt.wantErrorCause != nil {
+				require.ErrorIs(t, err, tt.wantErrorCause)
+			} else {
+				require.NoError(t, err)
+			}
+			apiMock.AssertExpectations(t)
+			oidcMock.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package ias
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+
+	eammetrics "github.com/kyma-project/eventing-auth-manager/internal/metrics"
+)
+
+// Defaults of the client-side rate limit. IAS allows 50 requests per second per originating IP address, and a full burst followed by
+// the sustained rate must stay below this limit.
+const (
+	DefaultRateLimitQPS   = 25
+	DefaultRateLimitBurst = 25
+)
+
+func (o ClientOptions) newRateLimiter() *rate.Limiter {
+	if o.RateLimitQPS <= 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+	return rate.NewLimiter(rate.Limit(o.RateLimitQPS), max(o.RateLimitBurst, 1))
+}
+
+// httpRequestDoer sends HTTP requests, e.g. an http.Client.
+type httpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// rateLimitedDoer delays the requests to an API of IAS until the rate limiter, which is shared by all APIs of a client, permits them.
+// The rate limiter is waited for with the context of the request before the request is passed to the HTTP client, so that the time
+// waiting in the queue doesn't count against the timeout of the HTTP client.
+type rateLimitedDoer struct {
+	next    httpRequestDoer
+	limiter *rate.Limiter
+	api     string
+}
+
+func newRateLimitedDoer(next httpRequestDoer, limiter *rate.Limiter, api string) *rateLimitedDoer {
+	return &rateLimitedDoer{
+		next:    next,
+		limiter: limiter,
+		api:     api,
+	}
+}
+
+func (d *rateLimitedDoer) Do(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	if err := d.limiter.Wait(req.Context()); err != nil {
+		return nil, errors.Wrap(err, "failed to wait for the IAS rate limiter")
+	}
+	eammetrics.RecordIasRateLimiterWait(d.api, time.Since(start))
+	return d.next.Do(req)
+}
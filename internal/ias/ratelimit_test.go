@@ -0,0 +1,124 @@
+package ias
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	eammetrics "github.com/kyma-project/eventing-auth-manager/internal/metrics"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+type doerFunc func(*http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func Test_rateLimitedDoer(t *testing.T) {
+	tests := []struct {
+		name         string
+		givenOptions ClientOptions
+		wantMinWait  time.Duration
+	}{
+		{
+			name:         "should delay requests exceeding the burst across all APIs",
+			givenOptions: ClientOptions{RateLimitQPS: 20, RateLimitBurst: 1},
+			// the first request is sent immediately and the other two wait 50ms each
+			wantMinWait: 90 * time.Millisecond,
+		},
+		{
+			name:         "should not delay requests within the burst",
+			givenOptions: ClientOptions{RateLimitQPS: 1, RateLimitBurst: 3},
+		},
+		{
+			name:         "should not delay requests when the rate limit is disabled",
+			givenOptions: ClientOptions{RateLimitQPS: 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			var sent atomic.Int32
+			next := doerFunc(func(*http.Request) (*http.Response, error) {
+				sent.Add(1)
+				return &http.Response{StatusCode: http.StatusOK}, nil
+			})
+			limiter := tt.givenOptions.newRateLimiter()
+			apiDoer := newRateLimitedDoer(next, limiter, eammetrics.IasAPIApplications)
+			oidcDoer := newRateLimitedDoer(next, limiter, eammetrics.IasAPIOIDC)
+			req, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, "https://test.com", nil)
+			require.NoError(t, err)
+
+			// when
+			start := time.Now()
+			for _, doer := range []httpRequestDoer{apiDoer, oidcDoer, apiDoer} {
+				_, err := doer.Do(req) //nolint:bodyclose // the stubbed response has no body.
+				require.NoError(t, err)
+			}
+			elapsed := time.Since(start)
+
+			// then
+			require.Equal(t, int32(3), sent.Load())
+			require.GreaterOrEqual(t, elapsed, tt.wantMinWait)
+			if tt.wantMinWait == 0 {
+				require.Less(t, elapsed, 50*time.Millisecond)
+			}
+		})
+	}
+}
+
+func Test_rateLimitedDoer_WaitNotCountedAgainstRequestTimeout(t *testing.T) {
+	// given
+	httpClient := &http.Client{
+		Timeout: 50 * time.Millisecond,
+		Transport: roundTripperFunc(func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}),
+	}
+	// the second request waits 100ms for the rate limiter, which is longer than the request timeout
+	limiter := ClientOptions{RateLimitQPS: 10, RateLimitBurst: 1}.newRateLimiter()
+	doer := newRateLimitedDoer(httpClient, limiter, eammetrics.IasAPIApplications)
+
+	for range 2 {
+		req, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, "https://test.com", nil)
+		require.NoError(t, err)
+
+		// when
+		res, err := doer.Do(req)
+
+		// then
+		require.NoError(t, err)
+		require.NoError(t, res.Body.Close())
+	}
+}
+
+func Test_rateLimitedDoer_CanceledContext(t *testing.T) {
+	// given
+	next := doerFunc(func(*http.Request) (*http.Response, error) {
+		t.Fatal("request must not be sent")
+		return nil, nil
+	})
+	limiter := ClientOptions{RateLimitQPS: 1, RateLimitBurst: 1}.newRateLimiter()
+	require.True(t, limiter.Allow())
+	doer := newRateLimitedDoer(next, limiter, eammetrics.IasAPIApplications)
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://test.com", nil)
+	require.NoError(t, err)
+
+	// when
+	_, err = doer.Do(req) //nolint:bodyclose // no response is returned.
+
+	// then
+	require.ErrorIs(t, err, context.Canceled)
+}
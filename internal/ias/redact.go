@@ -0,0 +1,66 @@
+package ias
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	"github.com/kyma-project/eventing-auth-manager/internal/redact"
+)
+
+var (
+	_ fmt.Formatter  = Application{}
+	_ logr.Marshaler = Application{}
+	_ fmt.Formatter  = Credentials{}
+	_ logr.Marshaler = Credentials{}
+)
+
+// Format prints the application with masked credentials for all verbs, so that the client secret and the private key never reach
+// the logs or error messages.
+func (a Application) Format(f fmt.State, _ rune) {
+	_, _ = fmt.Fprintf(f, "{id:%s clientID:%s tokenURL:%s certsURL:%s credentials:%s}", a.id, a.clientID, a.tokenURL, a.certsURL,
+		maskIfSet(a.clientSecret != "" || len(a.tlsKey) > 0))
+}
+
+// MarshalLog returns the application with masked credentials for structured logging.
+func (a Application) MarshalLog() any {
+	return struct {
+		ID          string `json:"id"`
+		ClientID    string `json:"clientId"`
+		TokenURL    string `json:"tokenUrl"`
+		CertsURL    string `json:"certsUrl"`
+		Credentials string `json:"credentials,omitempty"`
+	}{
+		ID:          a.id,
+		ClientID:    a.clientID,
+		TokenURL:    a.tokenURL,
+		CertsURL:    a.certsURL,
+		Credentials: maskIfSet(a.clientSecret != "" || len(a.tlsKey) > 0),
+	}
+}
+
+// Format prints the credentials with masked password for all verbs.
+func (c Credentials) Format(f fmt.State, _ rune) {
+	_, _ = fmt.Fprintf(f, "{URL:%s Username:%s Password:%s}", c.URL, c.Username, maskIfSet(c.Password != ""))
+}
+
+// MarshalLog returns the credentials with masked password for structured logging.
+func (c Credentials) MarshalLog() any {
+	return struct {
+		URL      string `json:"url"`
+		Username string `json:"username"`
+		Password string `json:"password,omitempty"`
+	}{
+		URL:      c.URL,
+		Username: c.Username,
+		Password: maskIfSet(c.Password != ""),
+	}
+}
+
+// maskIfSet returns the mask for a set secret value and an empty string otherwise, so that the output shows whether a value is set.
+func maskIfSet(set bool) string {
+	if set {
+		return redact.Mask
+	}
+	return ""
+}
@@ -0,0 +1,74 @@
+package ias
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+func Test_Application_Redaction(t *testing.T) {
+	tests := []struct {
+		name     string
+		givenApp Application
+	}{
+		{
+			name:     "should mask client secret",
+			givenApp: NewApplication("app-id", "client-id", "test-client-secret", "https://test.com/token", "https://test.com/certs"),
+		},
+		{
+			name: "should mask private key",
+			givenApp: NewApplicationWithCertificate("app-id", "client-id", []byte("test-tls-cert"), []byte("test-tls-key"),
+				"https://test.com/token", "https://test.com/certs"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, format := range []string{"%v", "%+v", "%#v", "%s"} {
+				// when
+				formatted := fmt.Sprintf(format, tt.givenApp)
+
+				// then
+				require.Equal(t, "{id:app-id clientID:client-id tokenURL:https://test.com/token certsURL:https://test.com/certs "+
+					"credentials:[REDACTED]}", formatted, format)
+			}
+
+			// when
+			logged := logToBuffer(t, "app", tt.givenApp)
+
+			// then
+			require.Contains(t, logged, `"app":{"id":"app-id","clientId":"client-id"`)
+			require.Contains(t, logged, `"credentials":"[REDACTED]"`)
+			require.NotContains(t, logged, "test-client-secret")
+			require.NotContains(t, logged, "test-tls-key")
+		})
+	}
+}
+
+func Test_Credentials_Redaction(t *testing.T) {
+	// given
+	credentials := &Credentials{URL: "https://test.com", Username: "user", Password: "test-password"}
+
+	// when
+	formatted := fmt.Sprintf("%+v", credentials)
+	wrapped := errors.Wrapf(errors.New("failed"), "invalid credentials %v", *credentials) //nolint:goerr113 // used one time only in tests.
+	logged := logToBuffer(t, "credentials", credentials)
+
+	// then
+	require.Equal(t, "{URL:https://test.com Username:user Password:[REDACTED]}", formatted)
+	require.NotContains(t, wrapped.Error(), "test-password")
+	require.Contains(t, logged, `"credentials":{"url":"https://test.com","username":"user","password":"[REDACTED]"}`)
+	require.NotContains(t, logged, "test-password")
+}
+
+// logToBuffer logs the value with the logger of the operator and returns the log output.
+func logToBuffer(t *testing.T, key string, value any) string {
+	t.Helper()
+	var buf bytes.Buffer
+	logger := zap.New(zap.WriteTo(&buf), zap.UseDevMode(false))
+	logger.Info("test", key, value)
+	return buf.String()
+}
@@ -0,0 +1,153 @@
+package ias
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/kyma-project/eventing-auth-manager/internal/audit"
+	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api"
+	"github.com/kyma-project/eventing-auth-manager/internal/logging"
+	"github.com/kyma-project/eventing-auth-manager/internal/redact"
+)
+
+var (
+	errListAPISecrets           = errors.New("failed to list api secrets")
+	errDeleteAPISecret          = errors.New("failed to delete api secret")
+	errAPISecretWithoutHint     = errors.New("api secret has no hint, so the previous api secrets can't be revoked")
+	errUnknownCertificateToKeep = errors.New("registered client certificate is unknown, so the previous client certificates can't be revoked")
+)
+
+// RegenerateCredentials issues new credentials of the credential type of the config for the existing application with the given name
+// and matching ownership. For the client secret, a new API secret is created, for the client certificate, a new certificate is
+// registered on the application. The previous credentials, also those of another credential type, stay valid until they are revoked
+// with RevokeCredentials after the new credentials were delivered. An application that doesn't exist is created.
+func (c *client) RegenerateCredentials(ctx context.Context, name string, config ApplicationConfig) (app Application, err error) {
+	defer func() { c.audit(audit.OperationRotateCredentials, name, app.GetID(), err) }()
+
+	existingApp, err := c.getApplicationByName(ctx, name, config.Owner)
+	if err != nil {
+		return Application{}, err
+	}
+	if existingApp == nil {
+		return c.CreateApplication(ctx, name, config)
+	}
+
+	appID := *existingApp.Id
+	if config.CredentialType == CredentialTypeCertificate {
+		return c.registerCertificate(ctx, name, appID)
+	}
+
+	secret, err := c.createAPISecret(ctx, appID)
+	if err != nil {
+		return Application{}, err
+	}
+	if ptr.Deref(secret.Hint, "") == "" {
+		// without the hint, the new api secret can't be told apart from the previous ones, so it must not be delivered
+		return Application{}, errAPISecretWithoutHint
+	}
+	logging.FromContext(ctx, logging.ComponentIAS).Info("Created new api secret", "name", name, "id", appID)
+
+	return c.newApplicationWithCredentials(ctx, appID, secret, nil)
+}
+
+// registerCertificate generates a new client certificate and registers it on the application in addition to the registered ones.
+func (c *client) registerCertificate(ctx context.Context, name string, appID uuid.UUID) (Application, error) {
+	actualApp, err := c.getApplication(ctx, appID)
+	if err != nil {
+		return Application{}, err
+	}
+	certificate, err := newClientCertificate(name)
+	if err != nil {
+		return Application{}, err
+	}
+	patch, err := newAddCertificatePatch(*actualApp, certificate)
+	if err != nil {
+		return Application{}, err
+	}
+	if err := c.patchApplication(ctx, appID, patch); err != nil {
+		return Application{}, err
+	}
+	logging.FromContext(ctx, logging.ComponentIAS).Info("Registered new client certificate", "name", name, "id", appID)
+
+	return c.newApplicationWithCredentials(ctx, appID, nil, &certificate)
+}
+
+// RevokeCredentials revokes the credentials that were issued by the operator for the application, except the credentials of the given
+// application, which were returned by RegenerateCredentials. This revokes both the API secrets and the client certificates, so that
+// the credentials of a previous credential type are revoked as well. It must only be called after the given credentials were delivered
+// to the managed runtime, so that the managed runtime never holds revoked credentials.
+func (c *client) RevokeCredentials(ctx context.Context, name string, app Application) (err error) {
+	defer func() { c.audit(audit.OperationRevokeCredentials, name, app.GetID(), err) }()
+
+	if app.HasCertificate() && app.certificateBase64DER == "" {
+		return errUnknownCertificateToKeep
+	}
+	if !app.HasCertificate() && app.secretHint == "" {
+		return errAPISecretWithoutHint
+	}
+	appID, err := uuid.Parse(app.GetID())
+	if err != nil {
+		return errors.Wrap(err, "invalid application ID")
+	}
+
+	// the hint is empty for an application with client certificate, so all api secrets of the operator are revoked
+	if err := c.revokeAPISecrets(ctx, appID, app.secretHint); err != nil {
+		return err
+	}
+	return c.revokeCertificates(ctx, appID, app.certificateBase64DER)
+}
+
+// revokeCertificates removes all client certificates registered by the operator on the application except the given one. An empty
+// certificate removes all client certificates of the operator.
+func (c *client) revokeCertificates(ctx context.Context, appID uuid.UUID, keepBase64DER string) error {
+	actualApp, err := c.getApplication(ctx, appID)
+	if err != nil {
+		return err
+	}
+	patch := newRemoveCertificatesPatch(*actualApp, keepBase64DER)
+	if len(patch.Operations) == 0 {
+		return nil
+	}
+	if err := c.patchApplication(ctx, appID, patch); err != nil {
+		return err
+	}
+	logging.FromContext(ctx, logging.ComponentIAS).Info("Revoked client certificates", "id", appID, "count", len(patch.Operations))
+	return nil
+}
+
+// revokeAPISecrets deletes all API secrets created by the operator for the application except the one with the given hint.
+func (c *client) revokeAPISecrets(ctx context.Context, appID uuid.UUID, keepHint string) error {
+	res, err := c.api.GetApiSecretsWithResponse(ctx, appID)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode() != http.StatusOK {
+		logging.FromContext(ctx, logging.ComponentIAS).Error(err, "Failed to list api secrets", "id", appID, "statusCode", res.StatusCode(), "body", redact.Body(res.Body))
+		return classifyStatusCode(errListAPISecrets, res.StatusCode())
+	}
+	if res.JSON200.Secrets == nil {
+		return nil
+	}
+
+	description := ptr.Deref(newSecretRequest().Description, "")
+	for _, secret := range *res.JSON200.Secrets {
+		hint := ptr.Deref(secret.Hint, "")
+		if hint == "" || hint == keepHint || ptr.Deref(secret.Description, "") != description {
+			continue
+		}
+		deleteRes, err := c.api.DeleteApiSecretWithResponse(ctx, appID, &api.DeleteApiSecretParams{Hint: hint})
+		if err != nil {
+			return err
+		}
+		if deleteRes.StatusCode() != http.StatusOK && deleteRes.StatusCode() != http.StatusNotFound {
+			logging.FromContext(ctx, logging.ComponentIAS).Error(err, "Failed to delete api secret", "id", appID, "statusCode", deleteRes.StatusCode(), "body", redact.Body(deleteRes.Body))
+			return classifyStatusCode(errDeleteAPISecret, deleteRes.StatusCode())
+		}
+		logging.FromContext(ctx, logging.ComponentIAS).Info("Revoked api secret", "id", appID, "hint", hint)
+	}
+	return nil
+}
@@ -0,0 +1,289 @@
+package ias
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api"
+	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api/mocks"
+)
+
+func Test_RegenerateCredentials(t *testing.T) {
+	appID := uuid.MustParse("90764f89-f041-4ccf-8da9-7a7c2d60d7fc")
+
+	tests := []struct {
+		name             string
+		givenAPIMock     func() *mocks.ClientWithResponsesInterface
+		wantSecret       string
+		wantErrorMessage string
+	}{
+		{
+			name: "should create new api secret without revoking previous api secrets",
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAllApplicationsWithResponseStatusOk(&clientMock, appID)
+				mockCreateAPISecretWithHint(&clientMock, appID, "new-secret", "new")
+				mockGetApplicationWithResponseStatusOK(&clientMock, appID)
+				return &clientMock
+			},
+			wantSecret: "new-secret",
+		},
+		{
+			name: "should return error when the new api secret has no hint",
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAllApplicationsWithResponseStatusOk(&clientMock, appID)
+				mockCreateAPISecretWithHint(&clientMock, appID, "new-secret", "")
+				return &clientMock
+			},
+			wantErrorMessage: errAPISecretWithoutHint.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			apiMock := tt.givenAPIMock()
+			client := client{
+				api: apiMock,
+			}
+			if tt.wantErrorMessage == "" {
+				client.oidcClient = mockClient(t, ptr.To("https://test.com/token"), ptr.To("https://test.com/certs"))
+			}
+
+			// when
+			app, err := client.RegenerateCredentials(context.TODO(), "Test-App-Name", ApplicationConfig{GlobalAccountID: "GAID", Owner: testOwner})
+
+			// then
+			if tt.wantErrorMessage != "" {
+				require.EqualError(t, err, tt.wantErrorMessage)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, appID.String(), app.GetID())
+			secret := app.ToSecret("test-secret", "test-ns")
+			require.Equal(t, []byte(tt.wantSecret), secret.Data["client_secret"])
+			require.Equal(t, []byte("clientIdMock"), secret.Data["client_id"])
+			require.Equal(t, "new", app.secretHint)
+			apiMock.AssertExpectations(t)
+			apiMock.AssertNotCalled(t, "GetApiSecretsWithResponse", mock.Anything, mock.Anything)
+			apiMock.AssertNotCalled(t, "DeleteApiSecretWithResponse", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func Test_RevokeCredentials(t *testing.T) {
+	appID := uuid.MustParse("90764f89-f041-4ccf-8da9-7a7c2d60d7fc")
+	appWithHint := func(hint string) Application {
+		app := NewApplication(appID.String(), "client-id", "new-secret", "https://test.com/token", "https://test.com/certs")
+		app.secretHint = hint
+		return app
+	}
+	appWithCertificate := func(base64DER string) Application {
+		app := NewApplicationWithCertificate(appID.String(), "client-id", []byte("cert"), []byte("key"), "https://test.com/token", "https://test.com/certs")
+		app.certificateBase64DER = base64DER
+		return app
+	}
+	mockGetApplicationWithCertificates := func(clientMock *mocks.ClientWithResponsesInterface, certificates ...api.ApiCertificateData) {
+		clientMock.On("GetApplicationWithResponse", mock.Anything, appID, mock.Anything).
+			Return(&api.GetApplicationResponse{
+				HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+				JSON200: &api.ApplicationResponse{
+					UrnSapIdentityApplicationSchemasExtensionSci10Authentication: &api.AuthenticationSchema{
+						ApiCertificates: &certificates,
+					},
+				},
+			}, nil)
+	}
+
+	tests := []struct {
+		name             string
+		givenApp         Application
+		givenAPIMock     func() *mocks.ClientWithResponsesInterface
+		wantErrorMessage string
+		// wantNoRevocation is true if no credentials must be revoked.
+		wantNoRevocation bool
+	}{
+		{
+			name:     "should revoke previous api secrets of the operator and keep the new api secret",
+			givenApp: appWithHint("new"),
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAPISecrets(&clientMock, appID, http.StatusOK,
+					api.ApiSecretData{Hint: ptr.To("new"), Description: ptr.To("eventing-auth-manager")},
+					api.ApiSecretData{Hint: ptr.To("old"), Description: ptr.To("eventing-auth-manager")},
+					api.ApiSecretData{Hint: ptr.To("foreign"), Description: ptr.To("created manually")},
+				)
+				clientMock.On("DeleteApiSecretWithResponse", mock.Anything, appID, &api.DeleteApiSecretParams{Hint: "old"}).
+					Return(&api.DeleteApiSecretResponse{HTTPResponse: &http.Response{StatusCode: http.StatusOK}}, nil).Once()
+				mockGetApplicationWithCertificates(&clientMock)
+				return &clientMock
+			},
+		},
+		{
+			name:     "should revoke api secrets and previous certificates of the operator and keep the new certificate",
+			givenApp: appWithCertificate("new-cert"),
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAPISecrets(&clientMock, appID, http.StatusOK,
+					api.ApiSecretData{Hint: ptr.To("old"), Description: ptr.To("eventing-auth-manager")},
+				)
+				clientMock.On("DeleteApiSecretWithResponse", mock.Anything, appID, &api.DeleteApiSecretParams{Hint: "old"}).
+					Return(&api.DeleteApiSecretResponse{HTTPResponse: &http.Response{StatusCode: http.StatusOK}}, nil).Once()
+				mockGetApplicationWithCertificates(&clientMock,
+					api.ApiCertificateData{Base64Certificate: "old-cert", Description: ptr.To("eventing-auth-manager")},
+					api.ApiCertificateData{Base64Certificate: "new-cert", Description: ptr.To("eventing-auth-manager")},
+				)
+				mockPatchApplicationWithBodyWithResponse(&clientMock, appID, http.StatusOK)
+				return &clientMock
+			},
+		},
+		{
+			name:     "should return error when previous api secrets can't be listed",
+			givenApp: appWithHint("new"),
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				clientMock := mocks.ClientWithResponsesInterface{}
+				mockGetAPISecrets(&clientMock, appID, http.StatusInternalServerError)
+				return &clientMock
+			},
+			wantErrorMessage: "failed to list api secrets",
+		},
+		{
+			name:     "should return error without revoking when the hint of the new api secret is unknown",
+			givenApp: appWithHint(""),
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				return &mocks.ClientWithResponsesInterface{}
+			},
+			wantErrorMessage: errAPISecretWithoutHint.Error(),
+			wantNoRevocation: true,
+		},
+		{
+			name:     "should return error without revoking when the new certificate is unknown",
+			givenApp: appWithCertificate(""),
+			givenAPIMock: func() *mocks.ClientWithResponsesInterface {
+				return &mocks.ClientWithResponsesInterface{}
+			},
+			wantErrorMessage: errUnknownCertificateToKeep.Error(),
+			wantNoRevocation: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			apiMock := tt.givenAPIMock()
+			client := client{
+				api: apiMock,
+			}
+
+			// when
+			err := client.RevokeCredentials(context.TODO(), "Test-App-Name", tt.givenApp)
+
+			// then
+			if tt.wantErrorMessage != "" {
+				require.EqualError(t, err, tt.wantErrorMessage)
+			} else {
+				require.NoError(t, err)
+			}
+			apiMock.AssertExpectations(t)
+			if tt.wantNoRevocation {
+				apiMock.AssertNotCalled(t, "DeleteApiSecretWithResponse", mock.Anything, mock.Anything, mock.Anything)
+				apiMock.AssertNotCalled(t, "PatchApplicationWithBodyWithResponse", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func Test_RegenerateCredentials_RegistersCertificateOnExistingApplication(t *testing.T) {
+	// given
+	appID := uuid.MustParse("90764f89-f041-4ccf-8da9-7a7c2d60d7fc")
+	apiMock := &mocks.ClientWithResponsesInterface{}
+	mockGetAllApplicationsWithResponseStatusOk(apiMock, appID)
+	apiMock.On("GetApplicationWithResponse", mock.Anything, appID, mock.Anything).
+		Return(&api.GetApplicationResponse{
+			HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+			JSON200: &api.ApplicationResponse{
+				UrnSapIdentityApplicationSchemasExtensionSci10Authentication: &api.AuthenticationSchema{
+					ClientId:        ptr.To("clientIdMock"),
+					ApiCertificates: &[]api.ApiCertificateData{{Base64Certificate: "old-cert", Description: ptr.To("eventing-auth-manager")}},
+				},
+			},
+		}, nil)
+	var body []byte
+	apiMock.On("PatchApplicationWithBodyWithResponse", mock.Anything, appID, mock.Anything, "application/json", mock.Anything).
+		Run(func(args mock.Arguments) {
+			body, _ = io.ReadAll(args.Get(4).(io.Reader))
+		}).
+		Return(&api.PatchApplicationResponse{HTTPResponse: &http.Response{StatusCode: http.StatusOK}}, nil).Once()
+	client := client{
+		api:        apiMock,
+		oidcClient: mockClient(t, ptr.To("https://test.com/token"), ptr.To("https://test.com/certs")),
+	}
+
+	// when
+	app, err := client.RegenerateCredentials(context.TODO(), "Test-App-Name",
+		ApplicationConfig{GlobalAccountID: "GAID", Owner: testOwner, CredentialType: CredentialTypeCertificate})
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, appID.String(), app.GetID())
+	require.True(t, app.HasCertificate())
+	require.NotEmpty(t, app.certificateBase64DER)
+	patch := applicationPatch{}
+	require.NoError(t, json.Unmarshal(body, &patch))
+	require.Len(t, patch.Operations, 1)
+	require.Equal(t, api.Add, patch.Operations[0].Op)
+	require.Equal(t, "/urn:sap:identity:application:schemas:extension:sci:1.0:Authentication/apiCertificates/-", patch.Operations[0].Path)
+	require.Equal(t, app.certificateBase64DER, patch.Operations[0].Value.(map[string]interface{})["base64Certificate"])
+	apiMock.AssertExpectations(t)
+	apiMock.AssertNotCalled(t, "DeleteApplicationWithResponse", mock.Anything, mock.Anything)
+	apiMock.AssertNotCalled(t, "CreateApplicationWithResponse", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_RegenerateCredentials_RecreatesMissingApplication(t *testing.T) {
+	// given
+	apiMock := &mocks.ClientWithResponsesInterface{}
+	mockGetAllApplicationsWithResponseStatusOkEmptyResponse(apiMock)
+	mockCreateApplicationWithResponseStatusInternalServerError(apiMock)
+	client := client{
+		api: apiMock,
+	}
+
+	// when
+	_, err := client.RegenerateCredentials(context.TODO(), "Test-App-Name", ApplicationConfig{GlobalAccountID: "GAID", Owner: testOwner})
+
+	// then
+	require.EqualError(t, err, "failed to create application")
+	apiMock.AssertNotCalled(t, "CreateApiSecretWithResponse", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func mockCreateAPISecretWithHint(clientMock *mocks.ClientWithResponsesInterface, appID uuid.UUID, secret, hint string) {
+	clientMock.On("CreateApiSecretWithResponse", mock.Anything, appID, newSecretRequest()).
+		Return(&api.CreateApiSecretResponse{
+			HTTPResponse: &http.Response{
+				StatusCode: http.StatusCreated,
+			},
+			JSON201: &api.ApiSecretResponse{
+				Secret: ptr.To(secret),
+				Hint:   ptr.To(hint),
+			},
+		}, nil).Once()
+}
+
+func mockGetAPISecrets(clientMock *mocks.ClientWithResponsesInterface, appID uuid.UUID, statusCode int, secrets ...api.ApiSecretData) {
+	clientMock.On("GetApiSecretsWithResponse", mock.Anything, appID).
+		Return(&api.GetApiSecretsResponse{
+			HTTPResponse: &http.Response{
+				StatusCode: statusCode,
+			},
+			JSON200: &api.ApiSecretsResponse{
+				Secrets: &secrets,
+			},
+		}, nil).Once()
+}
@@ -0,0 +1,216 @@
+package ias
+
+import (
+	"bytes"
+	"context"
+	"text/template"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	kcorev1 "k8s.io/api/core/v1"
+	kapierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	kpkgclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api"
+)
+
+// ApplicationTemplateKey is the key of the application template in the ConfigMap.
+const ApplicationTemplateKey = "template.yaml"
+
+// ApplicationTemplate contains the configurable settings of the IAS applications. The description and the values of the
+// advanced assertion attributes are rendered per runtime as Go templates, e.g. "runtime {{ .RuntimeID }}".
+type ApplicationTemplate struct {
+	Description                 *string                      `json:"description,omitempty"`
+	JwtValidity                 *int                         `json:"jwtValidity,omitempty"`
+	RestrictedGrantTypes        []string                     `json:"restrictedGrantTypes,omitempty"`
+	ProvidedAPIs                []ProvidedAPI                `json:"providedApis,omitempty"`
+	ConsumedAPIs                []ConsumedAPI                `json:"consumedApis,omitempty"`
+	AssertionAttributes         []AssertionAttribute         `json:"assertionAttributes,omitempty"`
+	AdvancedAssertionAttributes []AdvancedAssertionAttribute `json:"advancedAssertionAttributes,omitempty"`
+	ParentApplicationID         *string                      `json:"parentApplicationId,omitempty"`
+}
+
+type ProvidedAPI struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+type ConsumedAPI struct {
+	Name     string `json:"name"`
+	APIName  string `json:"apiName"`
+	AppID    string `json:"appId"`
+	ClientID string `json:"clientId,omitempty"`
+}
+
+type AssertionAttribute struct {
+	AssertionAttributeName string `json:"assertionAttributeName"`
+	UserAttributeName      string `json:"userAttributeName"`
+}
+
+type AdvancedAssertionAttribute struct {
+	AttributeName  string `json:"attributeName"`
+	AttributeValue string `json:"attributeValue"`
+}
+
+// templateData contains the values that can be used in the application template.
+type templateData struct {
+	RuntimeID       string
+	GlobalAccountID string
+}
+
+// Merge returns a copy of the template in which all settings that are set in the override replace the settings of the template.
+func (t ApplicationTemplate) Merge(override ApplicationTemplate) ApplicationTemplate {
+	merged := t
+	if override.Description != nil {
+		merged.Description = override.Description
+	}
+	if override.JwtValidity != nil {
+		merged.JwtValidity = override.JwtValidity
+	}
+	if override.RestrictedGrantTypes != nil {
+		merged.RestrictedGrantTypes = override.RestrictedGrantTypes
+	}
+	if override.ProvidedAPIs != nil {
+		merged.ProvidedAPIs = override.ProvidedAPIs
+	}
+	if override.ConsumedAPIs != nil {
+		merged.ConsumedAPIs = override.ConsumedAPIs
+	}
+	if override.AssertionAttributes != nil {
+		merged.AssertionAttributes = override.AssertionAttributes
+	}
+	if override.AdvancedAssertionAttributes != nil {
+		merged.AdvancedAssertionAttributes = override.AdvancedAssertionAttributes
+	}
+	if override.ParentApplicationID != nil {
+		merged.ParentApplicationID = override.ParentApplicationID
+	}
+	return merged
+}
+
+// apply renders the template for the given runtime and applies the settings to the application.
+func (t ApplicationTemplate) apply(app *api.Application, data templateData) error {
+	if t.Description != nil {
+		description, err := render("description", *t.Description, data)
+		if err != nil {
+			return err
+		}
+		app.Description = &description
+	}
+
+	if t.ParentApplicationID != nil {
+		parentID, err := uuid.Parse(*t.ParentApplicationID)
+		if err != nil {
+			return errors.Wrap(err, "invalid parent application ID in application template")
+		}
+		app.ParentApplicationId = &parentID
+	}
+
+	auth := app.UrnSapIdentityApplicationSchemasExtensionSci10Authentication
+	if t.JwtValidity != nil || t.RestrictedGrantTypes != nil {
+		auth.OpenIdConnectConfiguration = &api.OIDCConfiguration{}
+		if t.JwtValidity != nil {
+			auth.OpenIdConnectConfiguration.TokenPolicy = &api.TokenPolicy{JwtValidity: ptr.To(*t.JwtValidity)}
+		}
+		if t.RestrictedGrantTypes != nil {
+			grantTypes := make([]api.GrantType, 0, len(t.RestrictedGrantTypes))
+			for _, g := range t.RestrictedGrantTypes {
+				grantTypes = append(grantTypes, api.GrantType(g))
+			}
+			auth.OpenIdConnectConfiguration.RestrictedGrantTypes = &grantTypes
+		}
+	}
+
+	if t.ProvidedAPIs != nil {
+		providedAPIs := make([]api.ProvidedApi, 0, len(t.ProvidedAPIs))
+		for _, p := range t.ProvidedAPIs {
+			providedAPI := api.ProvidedApi{Name: ptr.To(p.Name)}
+			if p.Description != "" {
+				providedAPI.Description = ptr.To(p.Description)
+			}
+			providedAPIs = append(providedAPIs, providedAPI)
+		}
+		auth.ProvidedApis = &providedAPIs
+	}
+
+	if t.ConsumedAPIs != nil {
+		consumedAPIs := make([]api.ConsumedApi, 0, len(t.ConsumedAPIs))
+		for _, c := range t.ConsumedAPIs {
+			appID, err := uuid.Parse(c.AppID)
+			if err != nil {
+				return errors.Wrapf(err, "invalid app ID of consumed API %s in application template", c.Name)
+			}
+			consumedAPI := api.ConsumedApi{Name: c.Name, ApiName: c.APIName, AppId: appID}
+			if c.ClientID != "" {
+				consumedAPI.ClientId = ptr.To(c.ClientID)
+			}
+			consumedAPIs = append(consumedAPIs, consumedAPI)
+		}
+		auth.ConsumedApis = &consumedAPIs
+	}
+
+	if t.AssertionAttributes != nil {
+		assertionAttributes := make([]api.AssertionAttribute, 0, len(t.AssertionAttributes))
+		for _, a := range t.AssertionAttributes {
+			assertionAttributes = append(assertionAttributes, api.AssertionAttribute{
+				AssertionAttributeName: a.AssertionAttributeName,
+				UserAttributeName:      a.UserAttributeName,
+			})
+		}
+		auth.AssertionAttributes = &assertionAttributes
+	}
+
+	if t.AdvancedAssertionAttributes != nil {
+		advancedAssertionAttributes := make([]api.AdvancedAssertionAttribute, 0, len(t.AdvancedAssertionAttributes))
+		for _, a := range t.AdvancedAssertionAttributes {
+			value, err := render(a.AttributeName, a.AttributeValue, data)
+			if err != nil {
+				return err
+			}
+			advancedAssertionAttributes = append(advancedAssertionAttributes, api.AdvancedAssertionAttribute{
+				AttributeName:  ptr.To(a.AttributeName),
+				AttributeValue: ptr.To(value),
+			})
+		}
+		auth.AdvancedAssertionAttributes = &advancedAssertionAttributes
+	}
+
+	return nil
+}
+
+func render(name, text string, data templateData) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse application template field %s", name)
+	}
+	var b bytes.Buffer
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", errors.Wrapf(err, "failed to render application template field %s", name)
+	}
+	return b.String(), nil
+}
+
+// ReadApplicationTemplate reads the application template from the ConfigMap in the cluster. If the ConfigMap does not exist,
+// an empty template is returned, so that the applications are created with the default settings.
+func ReadApplicationTemplate(ctx context.Context, namespace, name string, k8sClient kpkgclient.Client) (ApplicationTemplate, error) {
+	cm := &kcorev1.ConfigMap{}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, cm); err != nil {
+		if kapierrors.IsNotFound(err) {
+			return ApplicationTemplate{}, nil
+		}
+		return ApplicationTemplate{}, err
+	}
+
+	t := ApplicationTemplate{}
+	data, exists := cm.Data[ApplicationTemplateKey]
+	if !exists {
+		return t, errors.Errorf("key %s is not found in application template configmap", ApplicationTemplateKey)
+	}
+	if err := yaml.UnmarshalStrict([]byte(data), &t); err != nil {
+		return t, errors.Wrap(err, "failed to parse application template")
+	}
+	return t, nil
+}
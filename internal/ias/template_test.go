@@ -0,0 +1,175 @@
+package ias
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	kcorev1 "k8s.io/api/core/v1"
+	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api"
+)
+
+func Test_newIasApplication_WithTemplate(t *testing.T) {
+	parentID := uuid.MustParse("5ab797c0-80a0-4ca4-ad7f-50a0f40231d6")
+	consumedAppID := uuid.MustParse("41de6fec-e0fc-47d7-b35c-3b19c4927e4f")
+
+	tests := []struct {
+		name          string
+		givenTemplate ApplicationTemplate
+		assertApp     func(*testing.T, api.Application)
+		wantError     string
+	}{
+		{
+			name:          "should create application with default settings when template is empty",
+			givenTemplate: ApplicationTemplate{},
+			assertApp: func(t *testing.T, app api.Application) {
+				t.Helper()
+				require.Equal(t, Ownership{}.describe(nil), *app.Description)
+				require.Nil(t, app.ParentApplicationId)
+				require.Nil(t, app.UrnSapIdentityApplicationSchemasExtensionSci10Authentication.OpenIdConnectConfiguration)
+			},
+		},
+		{
+			name: "should render template for the runtime",
+			givenTemplate: ApplicationTemplate{
+				Description:          ptr.To("runtime {{ .RuntimeID }} of {{ .GlobalAccountID }}"),
+				JwtValidity:          ptr.To(600),
+				RestrictedGrantTypes: []string{"clientCredentials"},
+				ProvidedAPIs:         []ProvidedAPI{{Name: "publish", Description: "Publish events"}},
+				ConsumedAPIs:         []ConsumedAPI{{Name: "eventing", APIName: "publish", AppID: consumedAppID.String()}},
+				AssertionAttributes:  []AssertionAttribute{{AssertionAttributeName: "email", UserAttributeName: "mail"}},
+				AdvancedAssertionAttributes: []AdvancedAssertionAttribute{
+					{AttributeName: "runtime_id", AttributeValue: "{{ .RuntimeID }}"},
+				},
+				ParentApplicationID: ptr.To(parentID.String()),
+			},
+			assertApp: func(t *testing.T, app api.Application) {
+				t.Helper()
+				auth := app.UrnSapIdentityApplicationSchemasExtensionSci10Authentication
+				require.Equal(t, Ownership{}.describe(ptr.To("runtime Test-App-Name of GAID")), *app.Description)
+				require.Equal(t, parentID, *app.ParentApplicationId)
+				require.Equal(t, 600, *auth.OpenIdConnectConfiguration.TokenPolicy.JwtValidity)
+				require.Equal(t, []api.GrantType{"clientCredentials"}, *auth.OpenIdConnectConfiguration.RestrictedGrantTypes)
+				require.Equal(t, []api.ProvidedApi{{Name: ptr.To("publish"), Description: ptr.To("Publish events")}}, *auth.ProvidedApis)
+				require.Equal(t, []api.ConsumedApi{{Name: "eventing", ApiName: "publish", AppId: consumedAppID}}, *auth.ConsumedApis)
+				require.Equal(t, []api.AssertionAttribute{{AssertionAttributeName: "email", UserAttributeName: "mail"}}, *auth.AssertionAttributes)
+				require.Equal(t, []api.AdvancedAssertionAttribute{
+					{AttributeName: ptr.To("runtime_id"), AttributeValue: ptr.To("Test-App-Name")},
+				}, *auth.AdvancedAssertionAttributes)
+			},
+		},
+		{
+			name:          "should return error when parent application ID is not a UUID",
+			givenTemplate: ApplicationTemplate{ParentApplicationID: ptr.To("invalid")},
+			wantError:     "invalid parent application ID in application template: invalid UUID length: 7",
+		},
+		{
+			name:          "should return error when template uses unknown placeholder",
+			givenTemplate: ApplicationTemplate{Description: ptr.To("{{ .Unknown }}")},
+			wantError:     `failed to render application template field description: template: description:1:3: executing "description" at <.Unknown>: can't evaluate field Unknown in type ias.templateData`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			app, err := newIasApplication("Test-App-Name", ApplicationConfig{GlobalAccountID: "GAID", Template: tt.givenTemplate})
+
+			// then
+			if tt.wantError != "" {
+				require.EqualError(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "Test-App-Name", *app.Name)
+			require.Equal(t, "GAID", *app.GlobalAccount)
+			tt.assertApp(t, app)
+		})
+	}
+}
+
+func Test_ApplicationTemplate_Merge(t *testing.T) {
+	// given
+	template := ApplicationTemplate{
+		Description: ptr.To("operator description"),
+		JwtValidity: ptr.To(3600),
+	}
+	override := ApplicationTemplate{
+		JwtValidity:          ptr.To(600),
+		RestrictedGrantTypes: []string{"clientCredentials"},
+	}
+
+	// when
+	merged := template.Merge(override)
+
+	// then
+	require.Equal(t, ApplicationTemplate{
+		Description:          ptr.To("operator description"),
+		JwtValidity:          ptr.To(600),
+		RestrictedGrantTypes: []string{"clientCredentials"},
+	}, merged)
+}
+
+func Test_ReadApplicationTemplate(t *testing.T) {
+	tests := []struct {
+		name          string
+		givenObjects  []kcorev1.ConfigMap
+		wantTemplate  ApplicationTemplate
+		wantErrorPart string
+	}{
+		{
+			name:         "should return empty template when configmap does not exist",
+			wantTemplate: ApplicationTemplate{},
+		},
+		{
+			name: "should read template from configmap",
+			givenObjects: []kcorev1.ConfigMap{
+				newTemplateConfigMap("description: test\njwtValidity: 600\n"),
+			},
+			wantTemplate: ApplicationTemplate{Description: ptr.To("test"), JwtValidity: ptr.To(600)},
+		},
+		{
+			name: "should return error when template contains unknown fields",
+			givenObjects: []kcorev1.ConfigMap{
+				newTemplateConfigMap("unknown: test\n"),
+			},
+			wantErrorPart: "failed to parse application template",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			builder := fake.NewClientBuilder()
+			for i := range tt.givenObjects {
+				builder = builder.WithObjects(&tt.givenObjects[i])
+			}
+
+			// when
+			template, err := ReadApplicationTemplate(context.TODO(), "test-ns", "test-template", builder.Build())
+
+			// then
+			if tt.wantErrorPart != "" {
+				require.ErrorContains(t, err, tt.wantErrorPart)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantTemplate, template)
+		})
+	}
+}
+
+func newTemplateConfigMap(template string) kcorev1.ConfigMap {
+	return kcorev1.ConfigMap{
+		ObjectMeta: kmetav1.ObjectMeta{
+			Name:      "test-template",
+			Namespace: "test-ns",
+		},
+		Data: map[string]string{ApplicationTemplateKey: template},
+	}
+}
@@ -0,0 +1,128 @@
+package ias
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/kyma-project/eventing-auth-manager/internal/tracing"
+)
+
+const (
+	attributeApplicationName = "ias.application.name"
+	attributeApplicationID   = "ias.application.id"
+)
+
+// tracingClient starts a span for every operation of the IAS client.
+type tracingClient struct {
+	next Client
+}
+
+func newTracingClient(next Client) Client {
+	return &tracingClient{next: next}
+}
+
+func (c *tracingClient) CreateApplication(ctx context.Context, name string, config ApplicationConfig) (Application, error) {
+	ctx, span := tracing.StartSpan(ctx, "ias.CreateApplication", attribute.String(attributeApplicationName, name))
+	app, err := c.next.CreateApplication(ctx, name, config)
+	if err == nil {
+		span.SetAttributes(attribute.String(attributeApplicationID, app.GetID()))
+	}
+	tracing.EndSpan(span, err)
+	return app, err
+}
+
+func (c *tracingClient) UpdateApplication(ctx context.Context, name string, config ApplicationConfig) (bool, error) {
+	ctx, span := tracing.StartSpan(ctx, "ias.UpdateApplication", attribute.String(attributeApplicationName, name))
+	updated, err := c.next.UpdateApplication(ctx, name, config)
+	span.SetAttributes(attribute.Bool("ias.application.updated", updated))
+	tracing.EndSpan(span, err)
+	return updated, err
+}
+
+func (c *tracingClient) DeleteApplication(ctx context.Context, name string, owner Ownership) error {
+	ctx, span := tracing.StartSpan(ctx, "ias.DeleteApplication", attribute.String(attributeApplicationName, name))
+	err := c.next.DeleteApplication(ctx, name, owner)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (c *tracingClient) DeleteApplicationByID(ctx context.Context, id string) error {
+	ctx, span := tracing.StartSpan(ctx, "ias.DeleteApplicationByID", attribute.String(attributeApplicationID, id))
+	err := c.next.DeleteApplicationByID(ctx, id)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (c *tracingClient) ReleaseApplication(ctx context.Context, name string, owner Ownership) error {
+	ctx, span := tracing.StartSpan(ctx, "ias.ReleaseApplication", attribute.String(attributeApplicationName, name))
+	err := c.next.ReleaseApplication(ctx, name, owner)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (c *tracingClient) ListApplications(ctx context.Context, filter Filter) ([]ApplicationInfo, error) {
+	ctx, span := tracing.StartSpan(ctx, "ias.ListApplications")
+	apps, err := c.next.ListApplications(ctx, filter)
+	span.SetAttributes(attribute.Int("ias.applications.count", len(apps)))
+	tracing.EndSpan(span, err)
+	return apps, err
+}
+
+func (c *tracingClient) GetApplicationByID(ctx context.Context, id string) (*ApplicationInfo, error) {
+	ctx, span := tracing.StartSpan(ctx, "ias.GetApplicationByID", attribute.String(attributeApplicationID, id))
+	app, err := c.next.GetApplicationByID(ctx, id)
+	tracing.EndSpan(span, err)
+	return app, err
+}
+
+func (c *tracingClient) GetApplicationByClientID(ctx context.Context, clientID string) (*ApplicationInfo, error) {
+	ctx, span := tracing.StartSpan(ctx, "ias.GetApplicationByClientID")
+	app, err := c.next.GetApplicationByClientID(ctx, clientID)
+	tracing.EndSpan(span, err)
+	return app, err
+}
+
+func (c *tracingClient) RegenerateCredentials(ctx context.Context, name string, config ApplicationConfig) (Application, error) {
+	ctx, span := tracing.StartSpan(ctx, "ias.RegenerateCredentials", attribute.String(attributeApplicationName, name))
+	app, err := c.next.RegenerateCredentials(ctx, name, config)
+	if err == nil {
+		span.SetAttributes(attribute.String(attributeApplicationID, app.GetID()))
+	}
+	tracing.EndSpan(span, err)
+	return app, err
+}
+
+func (c *tracingClient) RevokeCredentials(ctx context.Context, name string, app Application) error {
+	ctx, span := tracing.StartSpan(ctx, "ias.RevokeCredentials",
+		attribute.String(attributeApplicationName, name),
+		attribute.String(attributeApplicationID, app.GetID()),
+	)
+	err := c.next.RevokeCredentials(ctx, name, app)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (c *tracingClient) ResolveDuplicateApplications(ctx context.Context, name string, policy DuplicatePolicy, owner Ownership) (string, error) {
+	ctx, span := tracing.StartSpan(ctx, "ias.ResolveDuplicateApplications",
+		attribute.String(attributeApplicationName, name),
+		attribute.String("ias.duplicate_policy", string(policy)),
+	)
+	keptID, err := c.next.ResolveDuplicateApplications(ctx, name, policy, owner)
+	if err == nil {
+		span.SetAttributes(attribute.String(attributeApplicationID, keptID))
+	}
+	tracing.EndSpan(span, err)
+	return keptID, err
+}
+
+func (c *tracingClient) GetCredentials() *Credentials {
+	return c.next.GetCredentials()
+}
+
+func (c *tracingClient) Ping(ctx context.Context) error {
+	ctx, span := tracing.StartSpan(ctx, "ias.Ping")
+	err := c.next.Ping(ctx)
+	tracing.EndSpan(span, err)
+	return err
+}
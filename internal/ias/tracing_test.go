@@ -0,0 +1,60 @@
+package ias
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/kyma-project/eventing-auth-manager/internal/ias/internal/api/mocks"
+)
+
+func Test_tracingClient(t *testing.T) {
+	tests := []struct {
+		name           string
+		givenID        string
+		wantStatusCode codes.Code
+	}{
+		{
+			name:           "should record successful operation",
+			givenID:        "90764f89-f041-4ccf-8da9-7a7c2d60d7fc",
+			wantStatusCode: codes.Unset,
+		},
+		{
+			name:           "should record failed operation",
+			givenID:        "invalid",
+			wantStatusCode: codes.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			recorder := tracetest.NewSpanRecorder()
+			previous := otel.GetTracerProvider()
+			otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+			t.Cleanup(func() { otel.SetTracerProvider(previous) })
+			apiMock := &mocks.ClientWithResponsesInterface{}
+			if appID, err := uuid.Parse(tt.givenID); err == nil {
+				mockDeleteApplicationWithResponseStatusOk(apiMock, appID)
+			}
+			c := newTracingClient(&client{api: apiMock})
+
+			// when
+			_ = c.DeleteApplicationByID(context.TODO(), tt.givenID)
+
+			// then
+			spans := recorder.Ended()
+			require.Len(t, spans, 1)
+			require.Equal(t, "ias.DeleteApplicationByID", spans[0].Name())
+			require.Contains(t, spans[0].Attributes(), attribute.String(attributeApplicationID, tt.givenID))
+			require.Equal(t, tt.wantStatusCode, spans[0].Status().Code)
+			apiMock.AssertExpectations(t)
+		})
+	}
+}
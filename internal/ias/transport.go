@@ -0,0 +1,109 @@
+package ias
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var errNoCertificatesInCABundle = errors.New("CA bundle doesn't contain any PEM encoded certificate")
+
+// TransportOptions configure the HTTP transport of the requests to the Applications API and to the OIDC configuration of IAS. Timeouts
+// of 0 disable the respective timeout.
+type TransportOptions struct {
+	// RequestTimeout limits the duration of a request including the connection, the redirects, and reading the response body.
+	RequestTimeout time.Duration
+	// DialTimeout limits the time to establish a connection.
+	DialTimeout time.Duration
+	// KeepAlive is the interval of the keep-alive probes of an active connection. Negative values disable the keep-alive probes.
+	KeepAlive time.Duration
+	// TLSHandshakeTimeout limits the time to wait for the TLS handshake.
+	TLSHandshakeTimeout time.Duration
+	// ResponseHeaderTimeout limits the time to wait for the response headers after the request is written.
+	ResponseHeaderTimeout time.Duration
+	// IdleConnTimeout is the time after which an idle connection is closed.
+	IdleConnTimeout time.Duration
+	// MaxIdleConns limits the number of idle connections. 0 means no limit.
+	MaxIdleConns int
+	// MaxIdleConnsPerHost limits the number of idle connections to IAS.
+	MaxIdleConnsPerHost int
+	// ProxyURL is the proxy all requests are sent through. If nil, the proxy is taken from the environment variables HTTPS_PROXY and
+	// NO_PROXY.
+	ProxyURL *url.URL
+	// CABundle contains PEM encoded certificates that are trusted in addition to the system certificates.
+	CABundle []byte
+}
+
+// DefaultTransportOptions returns the transport options that bound every request to IAS, so that a hanging request can't stall a
+// reconciliation indefinitely.
+func DefaultTransportOptions() TransportOptions {
+	const (
+		defaultRequestTimeout        = 15 * time.Second
+		defaultDialTimeout           = 5 * time.Second
+		defaultKeepAlive             = 30 * time.Second
+		defaultTLSHandshakeTimeout   = 5 * time.Second
+		defaultResponseHeaderTimeout = 10 * time.Second
+		defaultIdleConnTimeout       = 90 * time.Second
+		defaultMaxIdleConns          = 100
+		defaultMaxIdleConnsPerHost   = 10
+	)
+	return TransportOptions{
+		RequestTimeout:        defaultRequestTimeout,
+		DialTimeout:           defaultDialTimeout,
+		KeepAlive:             defaultKeepAlive,
+		TLSHandshakeTimeout:   defaultTLSHandshakeTimeout,
+		ResponseHeaderTimeout: defaultResponseHeaderTimeout,
+		IdleConnTimeout:       defaultIdleConnTimeout,
+		MaxIdleConns:          defaultMaxIdleConns,
+		MaxIdleConnsPerHost:   defaultMaxIdleConnsPerHost,
+	}
+}
+
+// newTransport creates the HTTP transport that is shared by the requests to all APIs of an IAS client.
+func (o TransportOptions) newTransport() (*http.Transport, error) {
+	proxy := http.ProxyFromEnvironment
+	if o.ProxyURL != nil {
+		proxy = http.ProxyURL(o.ProxyURL)
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(o.CABundle) > 0 {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(o.CABundle) {
+			return nil, errNoCertificatesInCABundle
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   o.DialTimeout,
+		KeepAlive: o.KeepAlive,
+	}
+	return &http.Transport{
+		Proxy:                 proxy,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     true,
+		TLSClientConfig:       tlsConfig,
+		TLSHandshakeTimeout:   o.TLSHandshakeTimeout,
+		ResponseHeaderTimeout: o.ResponseHeaderTimeout,
+		IdleConnTimeout:       o.IdleConnTimeout,
+		MaxIdleConns:          o.MaxIdleConns,
+		MaxIdleConnsPerHost:   o.MaxIdleConnsPerHost,
+	}, nil
+}
+
+// validateCABundle checks that the CA bundle contains at least one PEM encoded certificate.
+func validateCABundle(caBundle []byte) error {
+	if !x509.NewCertPool().AppendCertsFromPEM(caBundle) {
+		return errNoCertificatesInCABundle
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package ias
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newTransport(t *testing.T) {
+	// given
+	proxyURL, err := url.Parse("http://proxy.test.com:3128")
+	require.NoError(t, err)
+	certificate, err := newClientCertificate("test-ca")
+	require.NoError(t, err)
+	options := DefaultTransportOptions()
+	options.ProxyURL = proxyURL
+	options.CABundle = certificate.certificatePEM
+
+	// when
+	transport, err := options.newTransport()
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, options.TLSHandshakeTimeout, transport.TLSHandshakeTimeout)
+	require.Equal(t, options.ResponseHeaderTimeout, transport.ResponseHeaderTimeout)
+	require.Equal(t, options.IdleConnTimeout, transport.IdleConnTimeout)
+	require.Equal(t, options.MaxIdleConns, transport.MaxIdleConns)
+	require.Equal(t, options.MaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, "https://tenant.accounts.ondemand.com", nil)
+	require.NoError(t, err)
+	actualProxyURL, err := transport.Proxy(req)
+	require.NoError(t, err)
+	require.Equal(t, proxyURL, actualProxyURL)
+	require.NotNil(t, transport.TLSClientConfig.RootCAs)
+}
+
+func Test_newTransport_InvalidCABundle(t *testing.T) {
+	// given
+	options := DefaultTransportOptions()
+	options.CABundle = []byte("not a certificate")
+
+	// when
+	_, err := options.newTransport()
+
+	// then
+	require.ErrorIs(t, err, errNoCertificatesInCABundle)
+}
+
+func Test_NewClient_Transport(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"token_endpoint":"https://test.com/token"}`))
+	}))
+	defer server.Close()
+	hangingServer := httptest.NewTLSServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer hangingServer.Close()
+	serverCA := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	hangingServerCA := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: hangingServer.Certificate().Raw})
+
+	tests := []struct {
+		name            string
+		givenTenantURL  string
+		givenCABundle   []byte
+		givenTimeout    time.Duration
+		wantErrContains string
+	}{
+		{
+			name:           "should trust the server with the CA bundle",
+			givenTenantURL: server.URL,
+			givenCABundle:  serverCA,
+		},
+		{
+			name:            "should not trust the server without the CA bundle",
+			givenTenantURL:  server.URL,
+			wantErrContains: "certificate",
+		},
+		{
+			name:            "should abort a hanging request after the request timeout",
+			givenTenantURL:  hangingServer.URL,
+			givenCABundle:   hangingServerCA,
+			givenTimeout:    50 * time.Millisecond,
+			wantErrContains: "Client.Timeout exceeded",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			options := DefaultClientOptions()
+			options.Transport.CABundle = tt.givenCABundle
+			if tt.givenTimeout > 0 {
+				options.Transport.RequestTimeout = tt.givenTimeout
+			}
+			iasClient, err := NewClient(tt.givenTenantURL, "user", "password", options)
+			require.NoError(t, err)
+			tracingIasClient, ok := iasClient.(*tracingClient)
+			require.True(t, ok)
+			c, ok := tracingIasClient.next.(*client)
+			require.True(t, ok)
+
+			// when
+			tokenURL, err := c.GetTokenURL(context.TODO())
+
+			// then
+			if tt.wantErrContains != "" {
+				require.ErrorContains(t, err, tt.wantErrContains)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "https://test.com/token", *tokenURL)
+			}
+		})
+	}
+}
@@ -1,16 +1,54 @@
 package ias
 
 import (
+	"time"
+
 	kcorev1 "k8s.io/api/core/v1"
 	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+type CredentialType string
+
+const (
+	// CredentialTypeSecret creates a client secret for the application.
+	CredentialTypeSecret CredentialType = "secret"
+	// CredentialTypeCertificate registers a client certificate on the application.
+	CredentialTypeCertificate CredentialType = "certificate"
+)
+
+// ApplicationConfig contains the desired configuration of the IAS application of a managed runtime.
+type ApplicationConfig struct {
+	GlobalAccountID string
+	CredentialType  CredentialType
+	Template        ApplicationTemplate
+	Owner           Ownership
+}
+
+// ApplicationInfo contains the attributes of an existing IAS application that identify it and its origin.
+type ApplicationInfo struct {
+	ID              string
+	Name            string
+	ClientID        string
+	Description     string
+	GlobalAccountID string
+	Created         time.Time
+	// Owner is the ownership recorded in the application or nil if the application is not managed by the operator.
+	Owner *Ownership
+}
+
 type Application struct {
 	id           string
 	clientID     string
 	clientSecret string
+	tlsCert      []byte
+	tlsKey       []byte
 	tokenURL     string
 	certsURL     string
+	// secretHint identifies the API secret of the client secret in IAS, so that the other API secrets can be revoked.
+	secretHint string
+	// certificateBase64DER identifies the API certificate of the client certificate in IAS, so that the other API certificates can be
+	// revoked.
+	certificateBase64DER string
 }
 
 func NewApplication(id, clientID, clientSecret, tokenURL, certsURL string) Application {
@@ -23,21 +61,45 @@ func NewApplication(id, clientID, clientSecret, tokenURL, certsURL string) Appli
 	}
 }
 
+// NewApplicationWithCertificate returns an application that authenticates with a client certificate instead of a client secret.
+func NewApplicationWithCertificate(id, clientID string, tlsCert, tlsKey []byte, tokenURL, certsURL string) Application {
+	return Application{
+		id:       id,
+		clientID: clientID,
+		tlsCert:  tlsCert,
+		tlsKey:   tlsKey,
+		tokenURL: tokenURL,
+		certsURL: certsURL,
+	}
+}
+
 func (a Application) ToSecret(name, ns string) kcorev1.Secret {
+	data := map[string][]byte{
+		"client_id": []byte(a.clientID),
+		"token_url": []byte(a.tokenURL),
+		"certs_url": []byte(a.certsURL),
+	}
+	if a.HasCertificate() {
+		data[kcorev1.TLSCertKey] = a.tlsCert
+		data[kcorev1.TLSPrivateKeyKey] = a.tlsKey
+	} else {
+		data["client_secret"] = []byte(a.clientSecret)
+	}
+
 	return kcorev1.Secret{
 		ObjectMeta: kmetav1.ObjectMeta{
 			Name:      name,
 			Namespace: ns,
 		},
-		Data: map[string][]byte{
-			"client_id":     []byte(a.clientID),
-			"client_secret": []byte(a.clientSecret),
-			"token_url":     []byte(a.tokenURL),
-			"certs_url":     []byte(a.certsURL),
-		},
+		Data: data,
 	}
 }
 
 func (a Application) GetID() string {
 	return a.id
 }
+
+// HasCertificate returns true if the application authenticates with a client certificate.
+func (a Application) HasCertificate() bool {
+	return len(a.tlsCert) > 0
+}
@@ -0,0 +1,138 @@
+package logging
+
+import (
+	"context"
+	"flag"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	crzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+// Components whose log level can be configured separately. A log line belongs to the last component in the name of its logger, so
+// that the logs of the IAS client called by a controller use the level of the IAS client.
+const (
+	ComponentIAS         = "ias"
+	ComponentSKR         = "skr"
+	ComponentControllers = "controllers"
+)
+
+// Keys of the correlation fields added to the loggers of a reconciliation. The controller-runtime adds the reconcileID.
+const (
+	KeyRuntimeID     = "runtimeId"
+	KeyApplicationID = "applicationId"
+)
+
+// FromContext returns the logger of the context named after the given component.
+func FromContext(ctx context.Context, component string) logr.Logger {
+	return log.FromContext(ctx).WithName(component)
+}
+
+// ComponentLevels maps components to their log level. Components without level use the level of the logger.
+type ComponentLevels map[string]zapcore.Level
+
+// Flag returns the flag value setting the level of the given component. The values are parsed like the flag zap-log-level.
+func (l ComponentLevels) Flag(component string) flag.Value {
+	return &componentLevelFlag{levels: l, component: component}
+}
+
+// Opts returns the option of the controller-runtime logger that applies the component levels. It must be passed after the options
+// that set the level of the logger.
+func (l ComponentLevels) Opts() crzap.Opts {
+	return func(o *crzap.Options) {
+		if len(l) == 0 {
+			return
+		}
+		defaultLevel := zapcore.LevelEnabler(zap.NewAtomicLevelAt(zap.InfoLevel))
+		if o.Level != nil {
+			defaultLevel = o.Level
+		} else if o.Development {
+			defaultLevel = zap.NewAtomicLevelAt(zap.DebugLevel)
+		}
+		// the core accepts the most verbose level, while the component levels are checked for each entry
+		minLevel := zapcore.LevelOf(defaultLevel)
+		for _, level := range l {
+			if level < minLevel {
+				minLevel = level
+			}
+		}
+		atomicMinLevel := zap.NewAtomicLevelAt(minLevel)
+		o.Level = &atomicMinLevel
+		o.ZapOpts = append(o.ZapOpts, zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return &componentLevelCore{Core: core, defaultLevel: defaultLevel, levels: l}
+		}))
+	}
+}
+
+// componentLevelCore drops the entries below the level of the component of their logger.
+type componentLevelCore struct {
+	zapcore.Core
+	defaultLevel zapcore.LevelEnabler
+	levels       ComponentLevels
+}
+
+func (c *componentLevelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &componentLevelCore{Core: c.Core.With(fields), defaultLevel: c.defaultLevel, levels: c.levels}
+}
+
+func (c *componentLevelCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.levelOf(entry.LoggerName).Enabled(entry.Level) {
+		return checked
+	}
+	return c.Core.Check(entry, checked)
+}
+
+func (c *componentLevelCore) levelOf(loggerName string) zapcore.LevelEnabler {
+	names := strings.Split(loggerName, ".")
+	for i := len(names) - 1; i >= 0; i-- {
+		if level, ok := c.levels[names[i]]; ok {
+			return level
+		}
+	}
+	return c.defaultLevel
+}
+
+// ParseLevel parses one of the levels debug, info, and error, or an integer greater than 0 for increasing verbosity.
+func ParseLevel(value string) (zapcore.Level, error) {
+	switch strings.ToLower(value) {
+	case "debug":
+		return zap.DebugLevel, nil
+	case "info":
+		return zap.InfoLevel, nil
+	case "error":
+		return zap.ErrorLevel, nil
+	}
+	verbosity, err := strconv.Atoi(value)
+	if err != nil || verbosity <= 0 || verbosity > 127 {
+		return 0, errors.Errorf("invalid log level %q", value)
+	}
+	return zapcore.Level(-verbosity), nil
+}
+
+type componentLevelFlag struct {
+	levels    ComponentLevels
+	component string
+	value     string
+}
+
+func (f *componentLevelFlag) Set(value string) error {
+	level, err := ParseLevel(value)
+	if err != nil {
+		return err
+	}
+	f.levels[f.component] = level
+	f.value = value
+	return nil
+}
+
+func (f *componentLevelFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.value
+}
@@ -0,0 +1,84 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	crzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+func Test_ComponentLevels(t *testing.T) {
+	// given
+	var buf bytes.Buffer
+	levels := ComponentLevels{ComponentIAS: zap.DebugLevel, ComponentSKR: zap.ErrorLevel}
+	logger := crzap.New(crzap.WriteTo(&buf), crzap.UseDevMode(false), levels.Opts())
+	ctx := log.IntoContext(context.TODO(), logger.WithName(ComponentControllers).WithValues(KeyRuntimeID, "runtime-id"))
+
+	// when
+	FromContext(ctx, ComponentIAS).V(1).Info("ias debug")
+	FromContext(ctx, ComponentSKR).Info("skr info")
+	FromContext(ctx, ComponentSKR).Error(nil, "skr error")
+	log.FromContext(ctx).V(1).Info("controllers debug")
+	log.FromContext(ctx).Info("controllers info")
+
+	// then
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	require.Contains(t, lines[0], `"logger":"controllers.ias","msg":"ias debug","runtimeId":"runtime-id"`)
+	require.Contains(t, lines[1], `"msg":"skr error"`)
+	require.Contains(t, lines[2], `"msg":"controllers info"`)
+}
+
+func Test_ParseLevel(t *testing.T) {
+	tests := []struct {
+		name      string
+		given     string
+		wantLevel zapcore.Level
+		wantErr   bool
+	}{
+		{name: "should parse debug", given: "debug", wantLevel: zap.DebugLevel},
+		{name: "should parse info", given: "INFO", wantLevel: zap.InfoLevel},
+		{name: "should parse error", given: "error", wantLevel: zap.ErrorLevel},
+		{name: "should parse verbosity", given: "3", wantLevel: zapcore.Level(-3)},
+		{name: "should fail for verbosity 0", given: "0", wantErr: true},
+		{name: "should fail for unknown level", given: "verbose", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			level, err := ParseLevel(tt.given)
+
+			// then
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantLevel, level)
+		})
+	}
+}
+
+func Test_ComponentLevels_Flag(t *testing.T) {
+	// given
+	levels := ComponentLevels{}
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	flags.Var(levels.Flag(ComponentIAS), "ias-log-level", "")
+
+	// when
+	err := flags.Parse([]string{"--ias-log-level=2"})
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, ComponentLevels{ComponentIAS: zapcore.Level(-2)}, levels)
+	require.Error(t, flags.Parse([]string{"--ias-log-level=invalid"}))
+}
@@ -0,0 +1,52 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	kmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const namespace = "eventing_auth_manager"
+
+// Resources that can be leaked when the finalizer of an EventingAuth is removed without a successful clean-up.
+const (
+	ResourceIasApplication = "ias_application"
+	ResourceSecret         = "secret"
+)
+
+// LeakedResources counts the resources that were left behind when the finalizer of an EventingAuth was removed without a
+// successful clean-up, e.g. because IAS was unreachable until the deletion deadline was exceeded.
+var LeakedResources = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Name:      "leaked_resources_total",
+	Help:      "Number of resources left behind by the forced removal of the finalizer of an EventingAuth.",
+}, []string{"resource", "reason"})
+
+// APIs of IAS whose requests are limited by the client-side rate limiter.
+const (
+	IasAPIApplications = "applications"
+	IasAPIOIDC         = "oidc"
+)
+
+// IasRateLimiterWait observes how long requests to IAS waited for the client-side rate limiter before they were sent.
+var IasRateLimiterWait = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: namespace,
+	Name:      "ias_rate_limiter_wait_seconds",
+	Help:      "Time requests to IAS waited for the client-side rate limiter.",
+	Buckets:   []float64{0, 0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+}, []string{"api"})
+
+func init() { //nolint:gochecknoinits // Metrics are registered with the registry of the controller-runtime on the package level.
+	kmetrics.Registry.MustRegister(LeakedResources, IasRateLimiterWait)
+}
+
+// RecordLeakedResource increases the number of the leaked resources of the given type.
+func RecordLeakedResource(resource, reason string) {
+	LeakedResources.WithLabelValues(resource, reason).Inc()
+}
+
+// RecordIasRateLimiterWait records the time a request to the given API of IAS waited for the client-side rate limiter.
+func RecordIasRateLimiterWait(api string, wait time.Duration) {
+	IasRateLimiterWait.WithLabelValues(api).Observe(wait.Seconds())
+}
@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RecordLeakedResource(t *testing.T) {
+	// given
+	LeakedResources.Reset()
+
+	// when
+	RecordLeakedResource(ResourceIasApplication, "force-delete")
+	RecordLeakedResource(ResourceIasApplication, "force-delete")
+	RecordLeakedResource(ResourceSecret, "deadline-exceeded")
+
+	// then
+	require.InDelta(t, 2, counterValue(t, ResourceIasApplication, "force-delete"), 0)
+	require.InDelta(t, 1, counterValue(t, ResourceSecret, "deadline-exceeded"), 0)
+	require.InDelta(t, 0, counterValue(t, ResourceSecret, "force-delete"), 0)
+}
+
+func counterValue(t *testing.T, labels ...string) float64 {
+	t.Helper()
+	m := &dto.Metric{}
+	require.NoError(t, LeakedResources.WithLabelValues(labels...).Write(m))
+	return m.GetCounter().GetValue()
+}
+
+func Test_RecordIasRateLimiterWait(t *testing.T) {
+	// given
+	IasRateLimiterWait.Reset()
+
+	// when
+	RecordIasRateLimiterWait(IasAPIApplications, 0)
+	RecordIasRateLimiterWait(IasAPIApplications, 200*time.Millisecond)
+	RecordIasRateLimiterWait(IasAPIOIDC, time.Second)
+
+	// then
+	applications := histogram(t, IasAPIApplications)
+	require.Equal(t, uint64(2), applications.GetSampleCount())
+	require.InDelta(t, 0.2, applications.GetSampleSum(), 0.0001)
+	oidc := histogram(t, IasAPIOIDC)
+	require.Equal(t, uint64(1), oidc.GetSampleCount())
+	require.InDelta(t, 1, oidc.GetSampleSum(), 0.0001)
+}
+
+func histogram(t *testing.T, api string) *dto.Histogram {
+	t.Helper()
+	m := &dto.Metric{}
+	observer, err := IasRateLimiterWait.GetMetricWithLabelValues(api)
+	require.NoError(t, err)
+	histogram, ok := observer.(prometheus.Histogram)
+	require.True(t, ok)
+	require.NoError(t, histogram.Write(m))
+	return m.GetHistogram()
+}
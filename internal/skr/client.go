@@ -12,6 +12,7 @@ import (
 	kpkgclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	eamias "github.com/kyma-project/eventing-auth-manager/internal/ias"
+	"github.com/kyma-project/eventing-auth-manager/internal/logging"
 )
 
 const (
@@ -22,8 +23,9 @@ const (
 
 type Client interface {
 	DeleteSecret(ctx context.Context) error
-	HasApplicationSecret(ctx context.Context) (bool, error)
+	GetApplicationSecret(ctx context.Context) (*kcorev1.Secret, error)
 	CreateSecret(ctx context.Context, app eamias.Application) (kcorev1.Secret, error)
+	UpdateSecret(ctx context.Context, app eamias.Application) (kcorev1.Secret, error)
 }
 
 type client struct {
@@ -35,12 +37,18 @@ var NewClient = func(k8sClient kpkgclient.Client, skrClusterID string) (Client,
 
 	secret := &kcorev1.Secret{}
 	if err := k8sClient.Get(context.Background(), types.NamespacedName{Name: kubeconfigSecretName, Namespace: KcpNamespace}, secret); err != nil {
+		if kapierrors.IsNotFound(err) {
+			return nil, &classifiedError{err: err, cause: ErrKubeconfigMissing}
+		}
 		return nil, err
 	}
 
 	kubeconfig := secret.Data["config"]
 	if len(kubeconfig) == 0 {
-		return nil, errors.Errorf("failed to find SKR cluster kubeconfig in secret %s", kubeconfigSecretName)
+		return nil, &classifiedError{
+			err:   errors.Errorf("failed to find SKR cluster kubeconfig in secret %s", kubeconfigSecretName),
+			cause: ErrKubeconfigMissing,
+		}
 	}
 
 	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
@@ -53,7 +61,7 @@ var NewClient = func(k8sClient kpkgclient.Client, skrClusterID string) (Client,
 		return nil, err
 	}
 
-	return &client{k8sClient: c}, nil
+	return newTracingClient(&client{k8sClient: c}, skrClusterID), nil
 }
 
 func (c *client) DeleteSecret(ctx context.Context) error {
@@ -62,22 +70,52 @@ func (c *client) DeleteSecret(ctx context.Context) error {
 		Name:      ApplicationSecretName,
 		Namespace: ApplicationSecretNamespace,
 	}, &s); err != nil {
-		return kpkgclient.IgnoreNotFound(err)
+		if kapierrors.IsNotFound(err) {
+			logging.FromContext(ctx, logging.ComponentSKR).V(1).Info("Application secret does not exist")
+		}
+		return classify(kpkgclient.IgnoreNotFound(err))
 	}
 
 	if err := c.k8sClient.Delete(ctx, &s); err != nil {
-		return err
+		return classify(err)
 	}
+	logging.FromContext(ctx, logging.ComponentSKR).V(1).Info("Deleted application secret", "secret", kpkgclient.ObjectKeyFromObject(&s))
 	return nil
 }
 
 func (c *client) CreateSecret(ctx context.Context, app eamias.Application) (kcorev1.Secret, error) {
 	appSecret := app.ToSecret(ApplicationSecretName, ApplicationSecretNamespace)
 	err := c.k8sClient.Create(ctx, &appSecret)
-	return appSecret, err
+	if err == nil {
+		logging.FromContext(ctx, logging.ComponentSKR).V(1).Info("Created application secret", "secret", kpkgclient.ObjectKeyFromObject(&appSecret))
+	}
+	return appSecret, classify(err)
+}
+
+// UpdateSecret replaces the credentials in the application secret with the credentials of the given application. The secret is
+// created if it doesn't exist.
+func (c *client) UpdateSecret(ctx context.Context, app eamias.Application) (kcorev1.Secret, error) {
+	desired := app.ToSecret(ApplicationSecretName, ApplicationSecretNamespace)
+
+	var actual kcorev1.Secret
+	err := c.k8sClient.Get(ctx, kpkgclient.ObjectKeyFromObject(&desired), &actual)
+	if kapierrors.IsNotFound(err) {
+		return c.CreateSecret(ctx, app)
+	}
+	if err != nil {
+		return kcorev1.Secret{}, classify(err)
+	}
+
+	actual.Data = desired.Data
+	if err := c.k8sClient.Update(ctx, &actual); err != nil {
+		return kcorev1.Secret{}, classify(err)
+	}
+	logging.FromContext(ctx, logging.ComponentSKR).V(1).Info("Updated application secret", "secret", kpkgclient.ObjectKeyFromObject(&actual))
+	return actual, nil
 }
 
-func (c *client) HasApplicationSecret(ctx context.Context) (bool, error) {
+// GetApplicationSecret returns the application secret with the credentials or nil if the secret doesn't exist.
+func (c *client) GetApplicationSecret(ctx context.Context) (*kcorev1.Secret, error) {
 	var s kcorev1.Secret
 	err := c.k8sClient.Get(ctx, kpkgclient.ObjectKey{
 		Name:      ApplicationSecretName,
@@ -85,12 +123,12 @@ func (c *client) HasApplicationSecret(ctx context.Context) (bool, error) {
 	}, &s)
 
 	if kapierrors.IsNotFound(err) {
-		return false, nil
+		return nil, nil //nolint:nilnil
 	}
 
 	if err != nil {
-		return false, err
+		return nil, classify(err)
 	}
 
-	return true, nil
+	return &s, nil
 }
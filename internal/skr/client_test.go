@@ -11,6 +11,8 @@ import (
 	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kpkgclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	eamias "github.com/kyma-project/eventing-auth-manager/internal/ias"
 )
 
 var errGetSecret = errors.New("error on getting secret")
@@ -50,6 +52,47 @@ func Test_NewClient(t *testing.T) {
 			// then
 			require.Error(t, err)
 			require.EqualError(t, tt.wantError, err.Error())
+			require.ErrorIs(t, err, ErrKubeconfigMissing)
+		})
+	}
+}
+
+func Test_classify(t *testing.T) {
+	tests := []struct {
+		name      string
+		givenErr  error
+		wantCause error
+	}{
+		{
+			name:      "should classify existing secret as conflict",
+			givenErr:  kapierrors.NewAlreadyExists(kcorev1.Resource("secrets"), ApplicationSecretName),
+			wantCause: ErrSecretConflict,
+		},
+		{
+			name:      "should classify conflicting update as conflict",
+			givenErr:  kapierrors.NewConflict(kcorev1.Resource("secrets"), ApplicationSecretName, errGetSecret),
+			wantCause: ErrSecretConflict,
+		},
+		{
+			name:      "should classify unavailable API server as unreachable",
+			givenErr:  kapierrors.NewServiceUnavailable("unavailable"),
+			wantCause: ErrUnreachable,
+		},
+		{
+			name:     "should not classify other errors",
+			givenErr: errGetSecret,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			err := classify(tt.givenErr)
+
+			// then
+			require.EqualError(t, err, tt.givenErr.Error())
+			for _, cause := range []error{ErrSecretConflict, ErrUnreachable} {
+				require.Equal(t, cause == tt.wantCause, errors.Is(err, cause))
+			}
 		})
 	}
 }
@@ -119,7 +162,7 @@ func Test_client_DeleteSecret(t *testing.T) {
 	}
 }
 
-func Test_client_HasApplicationSecret(t *testing.T) {
+func Test_client_GetApplicationSecret(t *testing.T) {
 	type fields struct {
 		k8sClient kpkgclient.Client
 	}
@@ -130,14 +173,14 @@ func Test_client_HasApplicationSecret(t *testing.T) {
 		wantErr error
 	}{
 		{
-			name: "should return false when secret is not found",
+			name: "should return nil when secret is not found",
 			fields: fields{
 				k8sClient: fake.NewClientBuilder().Build(),
 			},
 			want: false,
 		},
 		{
-			name: "should return true when secret is found",
+			name: "should return secret when secret is found",
 			fields: fields{
 				k8sClient: fake.NewClientBuilder().WithObjects(
 					&kcorev1.Secret{
@@ -177,7 +220,7 @@ func Test_client_HasApplicationSecret(t *testing.T) {
 				k8sClient: tt.fields.k8sClient,
 			}
 
-			got, err := c.HasApplicationSecret(context.TODO())
+			got, err := c.GetApplicationSecret(context.TODO())
 
 			if tt.wantErr != nil {
 				require.Error(t, err)
@@ -186,7 +229,56 @@ func Test_client_HasApplicationSecret(t *testing.T) {
 				require.NoError(t, err)
 			}
 
-			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.want, got != nil)
+			if got != nil {
+				require.Equal(t, ApplicationSecretName, got.Name)
+			}
+		})
+	}
+}
+
+func Test_client_UpdateSecret(t *testing.T) {
+	app := eamias.NewApplication("app-id", "new-client-id", "new-client-secret", "https://test.com/token", "https://test.com/certs")
+
+	tests := []struct {
+		name      string
+		k8sClient kpkgclient.Client
+	}{
+		{
+			name: "should replace credentials of existing secret",
+			k8sClient: fake.NewClientBuilder().WithObjects(
+				&kcorev1.Secret{
+					ObjectMeta: kmetav1.ObjectMeta{
+						Name:      ApplicationSecretName,
+						Namespace: ApplicationSecretNamespace,
+					},
+					Data: map[string][]byte{
+						"client_id":     []byte("old-client-id"),
+						"client_secret": []byte("old-client-secret"),
+					},
+				}).Build(),
+		},
+		{
+			name:      "should create secret when secret does not exist",
+			k8sClient: fake.NewClientBuilder().Build(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			c := &client{
+				k8sClient: tt.k8sClient,
+			}
+
+			// when
+			_, err := c.UpdateSecret(context.TODO(), app)
+
+			// then
+			require.NoError(t, err)
+			var actual kcorev1.Secret
+			require.NoError(t, tt.k8sClient.Get(context.TODO(), kpkgclient.ObjectKey{Name: ApplicationSecretName, Namespace: ApplicationSecretNamespace}, &actual))
+			require.Equal(t, []byte("new-client-id"), actual.Data["client_id"])
+			require.Equal(t, []byte("new-client-secret"), actual.Data["client_secret"])
 		})
 	}
 }
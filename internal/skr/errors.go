@@ -0,0 +1,45 @@
+package skr
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+	kapierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Errors that classify the cause of a failed operation on the managed runtime. They are matched with errors.Is, while the message of
+// the returned error remains the one of the failed operation.
+var (
+	ErrKubeconfigMissing = errors.New("kubeconfig of the managed runtime is missing")
+	ErrUnreachable       = errors.New("managed runtime is unreachable")
+	ErrSecretConflict    = errors.New("application secret conflicts with the secret on the managed runtime")
+)
+
+// classifiedError keeps the message of the error, but additionally matches the cause with errors.Is.
+type classifiedError struct {
+	err   error
+	cause error
+}
+
+func (e *classifiedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *classifiedError) Unwrap() []error {
+	return []error{e.err, e.cause}
+}
+
+// classify classifies the error of a request to the API server of the managed runtime.
+func classify(err error) error {
+	var netErr net.Error
+	switch {
+	case err == nil:
+		return nil
+	case kapierrors.IsAlreadyExists(err) || kapierrors.IsConflict(err):
+		return &classifiedError{err: err, cause: ErrSecretConflict}
+	case errors.As(err, &netErr) || kapierrors.IsServiceUnavailable(err) || kapierrors.IsTimeout(err) || kapierrors.IsServerTimeout(err):
+		return &classifiedError{err: err, cause: ErrUnreachable}
+	default:
+		return err
+	}
+}
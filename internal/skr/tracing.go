@@ -0,0 +1,54 @@
+package skr
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	kcorev1 "k8s.io/api/core/v1"
+
+	eamias "github.com/kyma-project/eventing-auth-manager/internal/ias"
+	"github.com/kyma-project/eventing-auth-manager/internal/tracing"
+)
+
+// tracingClient starts a span for every operation of the client of a managed runtime.
+type tracingClient struct {
+	next      Client
+	runtimeID string
+}
+
+func newTracingClient(next Client, runtimeID string) Client {
+	return &tracingClient{next: next, runtimeID: runtimeID}
+}
+
+func (c *tracingClient) DeleteSecret(ctx context.Context) error {
+	ctx, span := tracing.StartSpan(ctx, "skr.DeleteSecret", c.runtimeIDAttribute())
+	err := c.next.DeleteSecret(ctx)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (c *tracingClient) GetApplicationSecret(ctx context.Context) (*kcorev1.Secret, error) {
+	ctx, span := tracing.StartSpan(ctx, "skr.GetApplicationSecret", c.runtimeIDAttribute())
+	secret, err := c.next.GetApplicationSecret(ctx)
+	span.SetAttributes(attribute.Bool("skr.secret.exists", secret != nil))
+	tracing.EndSpan(span, err)
+	return secret, err
+}
+
+func (c *tracingClient) CreateSecret(ctx context.Context, app eamias.Application) (kcorev1.Secret, error) {
+	ctx, span := tracing.StartSpan(ctx, "skr.CreateSecret", c.runtimeIDAttribute())
+	secret, err := c.next.CreateSecret(ctx, app)
+	tracing.EndSpan(span, err)
+	return secret, err
+}
+
+func (c *tracingClient) UpdateSecret(ctx context.Context, app eamias.Application) (kcorev1.Secret, error) {
+	ctx, span := tracing.StartSpan(ctx, "skr.UpdateSecret", c.runtimeIDAttribute())
+	secret, err := c.next.UpdateSecret(ctx, app)
+	tracing.EndSpan(span, err)
+	return secret, err
+}
+
+func (c *tracingClient) runtimeIDAttribute() attribute.KeyValue {
+	return attribute.String("skr.runtime_id", c.runtimeID)
+}
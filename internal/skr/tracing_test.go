@@ -0,0 +1,37 @@
+package skr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_tracingClient(t *testing.T) {
+	// given
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	c := newTracingClient(&client{k8sClient: errorFakeClient{
+		Client:     fake.NewClientBuilder().Build(),
+		errorOnGet: errGetSecret,
+	}}, "runtime-id")
+
+	// when
+	_, err := c.GetApplicationSecret(context.TODO())
+
+	// then
+	require.ErrorIs(t, err, errGetSecret)
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	require.Equal(t, "skr.GetApplicationSecret", spans[0].Name())
+	require.Contains(t, spans[0].Attributes(), attribute.String("skr.runtime_id", "runtime-id"))
+	require.Equal(t, codes.Error, spans[0].Status().Code)
+}
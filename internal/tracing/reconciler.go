@@ -0,0 +1,36 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	kcontrollerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// reconciler starts a span for every reconciliation and adds the trace ID to the logger of the reconciliation, so that the logs can be
+// correlated with the requests to IAS and the managed runtimes.
+type reconciler struct {
+	name string
+	next reconcile.Reconciler
+}
+
+// NewReconciler wraps the reconciler of the given controller with a span per reconciliation.
+func NewReconciler(name string, next reconcile.Reconciler) reconcile.Reconciler {
+	return &reconciler{name: name, next: next}
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, req kcontrollerruntime.Request) (kcontrollerruntime.Result, error) {
+	ctx, span := StartSpan(ctx, r.name+".Reconcile",
+		attribute.String("k8s.namespace.name", req.Namespace),
+		attribute.String("k8s.object.name", req.Name),
+	)
+	if spanContext := span.SpanContext(); spanContext.HasTraceID() {
+		ctx = log.IntoContext(ctx, log.FromContext(ctx).WithValues("traceID", spanContext.TraceID().String()))
+	}
+
+	result, err := r.next.Reconcile(ctx, req)
+	EndSpan(span, err)
+	return result, err
+}
@@ -0,0 +1,93 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/go-logr/logr/funcr"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	kcontrollerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func Test_NewReconciler(t *testing.T) {
+	errReconcile := errors.New("reconcile failed") //nolint:goerr113 // used one time only in tests.
+	tests := []struct {
+		name           string
+		givenErr       error
+		wantStatusCode codes.Code
+	}{
+		{
+			name:           "should record successful reconciliation",
+			wantStatusCode: codes.Unset,
+		},
+		{
+			name:           "should record failed reconciliation",
+			givenErr:       errReconcile,
+			wantStatusCode: codes.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			recorder := recordSpans(t)
+			var loggedTraceID string
+			logger := funcr.New(func(_, args string) { loggedTraceID = args }, funcr.Options{})
+			ctx := log.IntoContext(context.TODO(), logger)
+			next := reconcile.Func(func(ctx context.Context, _ kcontrollerruntime.Request) (kcontrollerruntime.Result, error) {
+				log.FromContext(ctx).Info("reconciling")
+				return kcontrollerruntime.Result{}, tt.givenErr
+			})
+			req := kcontrollerruntime.Request{}
+			req.Namespace, req.Name = "kcp-system", "runtime-id"
+
+			// when
+			_, err := NewReconciler("EventingAuth", next).Reconcile(ctx, req)
+
+			// then
+			require.ErrorIs(t, err, tt.givenErr)
+			spans := recorder.Ended()
+			require.Len(t, spans, 1)
+			require.Equal(t, "EventingAuth.Reconcile", spans[0].Name())
+			require.Contains(t, spans[0].Attributes(), attribute.String("k8s.namespace.name", "kcp-system"))
+			require.Contains(t, spans[0].Attributes(), attribute.String("k8s.object.name", "runtime-id"))
+			require.Equal(t, tt.wantStatusCode, spans[0].Status().Code)
+			require.Contains(t, loggedTraceID, spans[0].SpanContext().TraceID().String())
+		})
+	}
+}
+
+func Test_InjectTraceContext(t *testing.T) {
+	// given
+	recordSpans(t)
+	shutdown, err := Setup(context.TODO(), Options{})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, shutdown(context.TODO())) }()
+	ctx, span := StartSpan(context.TODO(), "test")
+	defer span.End()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://test.com", nil)
+	require.NoError(t, err)
+
+	// when
+	require.NoError(t, InjectTraceContext(ctx, req))
+
+	// then
+	require.Contains(t, req.Header.Get("traceparent"), span.SpanContext().TraceID().String())
+}